@@ -0,0 +1,298 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// maxChangelogCodepoints caps the codepoints listed per block on the
+// changelog, the /age page of the version has the rest.
+const maxChangelogCodepoints = 256
+
+// ChangelogBlock is what a Unicode version added to one block.
+type ChangelogBlock struct {
+	Block string `json:"block"`
+	Slug  string `json:"-"`
+	// New is set when the block got its first characters in this version.
+	New        bool            `json:"new"`
+	Added      int             `json:"added"`
+	Codepoints []CodepointLink `json:"codepoints"`
+	Truncated  bool            `json:"truncated"`
+
+	start rune
+}
+
+// ChangelogVersion is what a single Unicode version added.
+type ChangelogVersion struct {
+	Version string           `json:"version"`
+	Added   int              `json:"added"`
+	Blocks  []ChangelogBlock `json:"blocks"`
+}
+
+// Changelog lists the characters added after From up to and including To,
+// newest version first.
+type Changelog struct {
+	From     string             `json:"from"`
+	To       string             `json:"to"`
+	Versions []string           `json:"versions"`
+	Added    int                `json:"added"`
+	Changes  []ChangelogVersion `json:"changes"`
+}
+
+func ageList() []ucdRange {
+	if ucd != nil && ucd.ages != nil {
+		return ucd.ages
+	}
+	return builtinAges
+}
+
+// getAge returns the version of Unicode that introduced codepoint, "" when
+// DerivedAge.txt does not list it.
+func getAge(codepoint rune) string {
+	if age, ok := findUCDRange(ageList(), codepoint); ok {
+		return age.value
+	}
+	return ""
+}
+
+// compareAgeVersions orders "major.minor" versions numerically, so that 10.0
+// comes after 9.0.
+func compareAgeVersions(a, b string) int {
+	partsA, partsB := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		numberA, _ := strconv.Atoi(partsA[i])
+		numberB, _ := strconv.Atoi(partsB[i])
+		if numberA != numberB {
+			return numberA - numberB
+		}
+	}
+	return len(partsA) - len(partsB)
+}
+
+// ageVersions returns every version named in DerivedAge.txt, oldest first.
+func ageVersions() []string {
+	seen := map[string]bool{}
+	versions := []string{}
+	for _, age := range ageList() {
+		if !seen[age.value] {
+			seen[age.value] = true
+			versions = append(versions, age.value)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return compareAgeVersions(versions[i], versions[j]) < 0 })
+	return versions
+}
+
+// lookupAgeVersion matches input against the known versions, accepting
+// "14", "14.0", "14.0.0" and a leading "v".
+func lookupAgeVersion(input string) (version string, ok bool) {
+	input = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(input)), "v")
+	for _, version := range ageVersions() {
+		if input == version || input+".0" == version || strings.TrimSuffix(input, ".0") == version {
+			return version, true
+		}
+	}
+	return "", false
+}
+
+// ageSuggestions links the most recent versions.
+func ageSuggestions() (suggestions []suggestion) {
+	versions := ageVersions()
+	for i := len(versions) - 1; i >= 0 && len(suggestions) < 5; i-- {
+		suggestions = append(suggestions, suggestion{"Unicode " + versions[i], "/age/" + versions[i]})
+	}
+	return append(suggestions, suggestion{"changelog", "/changelog"})
+}
+
+func getAgeRangeTable(version string) *unicode.RangeTable {
+	var ranges []ucdRange
+	for _, age := range ageList() {
+		if age.value == version {
+			ranges = append(ranges, ucdRange{lo: age.lo, hi: age.hi})
+		}
+	}
+	return newRangeTable(ranges)
+}
+
+func lookupAgeRange(route string) (name string, rtLiteral *unicode.RangeTable, ok bool) {
+	version, ok := lookupAgeVersion(route)
+	if !ok {
+		return "", nil, false
+	}
+	return "Unicode " + version, getAgeRangeTable(version), true
+}
+
+// getChangelog collects the characters added in the versions after from up
+// to and including to, by version and block.
+func getChangelog(from, to string) Changelog {
+	changelog := Changelog{
+		From:     from,
+		To:       to,
+		Versions: ageVersions(),
+		Changes:  []ChangelogVersion{},
+	}
+
+	type blockAge struct {
+		version, block string
+	}
+	// blocks are split across ranges of DerivedAge.txt, so every part of a
+	// block is collected before the changelog is put together
+	parts := map[blockAge]*ChangelogBlock{}
+	firstVersions := map[string]string{}
+
+	for _, age := range ageList() {
+		for codepoint := age.lo; codepoint <= age.hi; {
+			blockName, end := "No_Block", codepoint
+			if block, ok := getBlock(codepoint); ok {
+				blockName, end = block.value, block.hi
+				if age.hi < end {
+					end = age.hi
+				}
+			}
+
+			if first, ok := firstVersions[blockName]; !ok || compareAgeVersions(age.value, first) < 0 {
+				firstVersions[blockName] = age.value
+			}
+
+			if compareAgeVersions(age.value, from) > 0 && compareAgeVersions(age.value, to) <= 0 {
+				key := blockAge{age.value, blockName}
+				part, ok := parts[key]
+				if !ok {
+					part = &ChangelogBlock{Block: blockName, Slug: blockSlug(blockName), Codepoints: []CodepointLink{}, start: codepoint}
+					parts[key] = part
+				}
+				for added := codepoint; added <= end; added++ {
+					part.Added++
+					// surrogates are counted but have no page to link to
+					if unicode.Is(unicode.Cs, added) {
+						continue
+					}
+					if len(part.Codepoints) == maxChangelogCodepoints {
+						part.Truncated = true
+						continue
+					}
+					part.Codepoints = append(part.Codepoints, CodepointLink{fmt.Sprintf("%U", added), string(added)})
+				}
+			}
+
+			codepoint = end + 1
+		}
+	}
+
+	changes := map[string]*ChangelogVersion{}
+	for key, part := range parts {
+		part.New = firstVersions[key.block] == key.version
+		change, ok := changes[key.version]
+		if !ok {
+			change = &ChangelogVersion{Version: key.version}
+			changes[key.version] = change
+		}
+		change.Added += part.Added
+		change.Blocks = append(change.Blocks, *part)
+	}
+
+	for _, change := range changes {
+		sort.Slice(change.Blocks, func(i, j int) bool { return change.Blocks[i].start < change.Blocks[j].start })
+		changelog.Added += change.Added
+		changelog.Changes = append(changelog.Changes, *change)
+	}
+	sort.Slice(changelog.Changes, func(i, j int) bool {
+		return compareAgeVersions(changelog.Changes[i].Version, changelog.Changes[j].Version) > 0
+	})
+
+	return changelog
+}
+
+// getChangelogRequest reads the "from" and "to" parameters, which default
+// to the last two versions.
+func getChangelogRequest(request *http.Request) (Changelog, error) {
+	versions := ageVersions()
+	from, to := versions[0], versions[len(versions)-1]
+	if len(versions) > 1 {
+		from = versions[len(versions)-2]
+	}
+
+	query := request.URL.Query()
+	for _, parameter := range []struct {
+		name    string
+		version *string
+	}{
+		{"from", &from},
+		{"to", &to},
+	} {
+		input := query.Get(parameter.name)
+		if input == "" {
+			continue
+		}
+		version, ok := lookupAgeVersion(input)
+		if !ok {
+			return Changelog{}, &inputError{errMalformedInput, input, ageSuggestions()}
+		}
+		*parameter.version = version
+	}
+
+	if compareAgeVersions(from, to) >= 0 {
+		return Changelog{}, &inputError{errMalformedInput, fmt.Sprintf("from %s to %s", from, to), []suggestion{
+			{fmt.Sprintf("from %s to %s", to, from), fmt.Sprintf("/changelog?from=%s&to=%s", to, from)},
+		}}
+	}
+
+	return getChangelog(from, to), nil
+}
+
+func serveAge(writer http.ResponseWriter, request *http.Request, route string, timer time.Time) {
+	version, ok := lookupAgeVersion(route)
+	if !ok {
+		serveError(writer, request, &inputError{errUnknownRange, route, ageSuggestions()}, timer)
+		return
+	}
+
+	serveRangeTable(writer, request, "Unicode "+version, "/api/v1/age/"+version, getAgeRangeTable(version), timer)
+}
+
+func serveChangelogJSON(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	changelog, err := getChangelogRequest(request)
+	if err != nil {
+		serveJSONError(writer, request, err, timer)
+		return
+	}
+
+	writer = setJSONHeaders(writer)
+	serveJSON(writer, request, changelog, timer)
+}
+
+func serveChangelog(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	writer.Header().Add("Vary", "Accept")
+	if wantsJSON(request) {
+		serveChangelogJSON(writer, request, timer)
+		return
+	}
+
+	changelog, err := getChangelogRequest(request)
+	if err != nil {
+		serveError(writer, request, err, timer)
+		return
+	}
+
+	writer = setHeaders(writer)
+
+	data := struct {
+		Changelog
+		UnicodeVersion string
+	}{
+		Changelog:      changelog,
+		UnicodeVersion: unicodeVersion(),
+	}
+
+	templateFiles := []string{
+		"./template/base.template.html",
+		"./template/changelog.template.html",
+	}
+
+	serveFilesFromTemplate(writer, request, templateFiles, data, timer)
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed template public
+var embeddedAssets embed.FS
+
+// assetDirectory holds template/ and public/ on disk, to be used instead of
+// the copies embedded into the binary, see useAssetDirectory.
+var assetDirectory string
+
+// assets holds template/ and public/, from assetDirectory when it is set and
+// embedded otherwise.
+var assets fs.FS = embeddedAssets
+
+// useAssetDirectory serves assets from directory, or embedded when it is
+// empty. It has to be called before anything is rendered.
+func useAssetDirectory(directory string) {
+	assetDirectory = directory
+	if directory == "" {
+		assets = embeddedAssets
+		return
+	}
+	assets = os.DirFS(directory)
+}
+
+// publicAssets is public/ of assets, which is served as is.
+func publicAssets() fs.FS {
+	public, err := fs.Sub(assets, "public")
+	if err != nil {
+		log.Fatalf("assets: %v", err)
+	}
+	return public
+}
+
+// staticContentTypes covers the extensions missing from the table built into
+// mime, which is all there is in containers without /etc/mime.types.
+var staticContentTypes = map[string]string{
+	".ico": "image/x-icon",
+	".txt": "text/plain; charset=utf-8",
+}
+
+func staticContentType(name string) string {
+	extension := path.Ext(name)
+	if contentType, ok := staticContentTypes[extension]; ok {
+		return contentType
+	}
+	if contentType := mime.TypeByExtension(extension); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
+
+// hashedName puts hash in front of the extension of name, i.e.
+// "res/shared.3f2a1b9c.css" for "res/shared.css".
+func hashedName(name, hash string) string {
+	extension := path.Ext(name)
+	return strings.TrimSuffix(name, extension) + "." + hash + extension
+}
+
+// staticHashes maps every file in public/ to a hash of its content, and the
+// hashed names back to the files.
+type staticHashes struct {
+	hashes map[string]string
+	names  map[string]string
+}
+
+var (
+	staticIndex     staticHashes
+	staticIndexOnce sync.Once
+)
+
+func getStaticHashes() staticHashes {
+	staticIndexOnce.Do(func() {
+		index := staticHashes{hashes: map[string]string{}, names: map[string]string{}}
+		err := fs.WalkDir(publicAssets(), ".", func(name string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return err
+			}
+			content, err := fs.ReadFile(publicAssets(), name)
+			if err != nil {
+				return err
+			}
+			sum := sha256.Sum256(content)
+			hash := hex.EncodeToString(sum[:4])
+			index.hashes[name] = hash
+			index.names[hashedName(name, hash)] = name
+			return nil
+		})
+		if err != nil {
+			log.Printf("assets: %v", err)
+		}
+		staticIndex = index
+	})
+	return staticIndex
+}
+
+// assetPath returns the URL of name in public/ with the hash of its content
+// in the file name, so it can be cached for good and still be replaced by
+// a new release. With templateReload the plain URL is used, as files change
+// under the running server.
+func assetPath(name string) string {
+	hash, ok := getStaticHashes().hashes[name]
+	if !ok || templateReload {
+		return absoluteURL("/" + name)
+	}
+	return absoluteURL("/" + hashedName(name, hash))
+}
+
+// serveStatic serves a file of public/, either by its name or by the hashed
+// name from assetPath.
+func serveStatic(writer http.ResponseWriter, request *http.Request, route string, timer time.Time) {
+	name := strings.TrimPrefix(path.Clean(route), "/")
+	cacheControl := "public, max-age=3600"
+	if original, ok := getStaticHashes().names[name]; ok {
+		name = original
+		cacheControl = "public, max-age=31536000, immutable"
+	}
+
+	var content []byte
+	err := errNotFound
+	if fs.ValidPath(name) {
+		content, err = fs.ReadFile(publicAssets(), name)
+	}
+	if err != nil {
+		serveError(writer, request, &inputError{errNotFound, request.URL.Path, rangeSuggestions(path.Base(request.URL.Path))}, timer)
+		return
+	}
+
+	sum := sha256.Sum256(content)
+	writer.Header().Set("Content-Type", staticContentType(name))
+	writer.Header().Set("Cache-Control", cacheControl)
+	writer.Header().Set("ETag", `"`+hex.EncodeToString(sum[:4])+`"`)
+	http.ServeContent(writer, request, name, time.Time{}, bytes.NewReader(content))
+	logNow(request, timer)
+}
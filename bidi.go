@@ -0,0 +1,895 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var bidiClassNames = map[string]string{
+	"L":   "Left_To_Right",
+	"R":   "Right_To_Left",
+	"AL":  "Arabic_Letter",
+	"EN":  "European_Number",
+	"ES":  "European_Separator",
+	"ET":  "European_Terminator",
+	"AN":  "Arabic_Number",
+	"CS":  "Common_Separator",
+	"NSM": "Nonspacing_Mark",
+	"BN":  "Boundary_Neutral",
+	"B":   "Paragraph_Separator",
+	"S":   "Segment_Separator",
+	"WS":  "White_Space",
+	"ON":  "Other_Neutral",
+	"LRE": "Left_To_Right_Embedding",
+	"LRO": "Left_To_Right_Override",
+	"RLE": "Right_To_Left_Embedding",
+	"RLO": "Right_To_Left_Override",
+	"PDF": "Pop_Directional_Format",
+	"LRI": "Left_To_Right_Isolate",
+	"RLI": "Right_To_Left_Isolate",
+	"FSI": "First_Strong_Isolate",
+	"PDI": "Pop_Directional_Isolate",
+}
+
+// Bidi is how a single codepoint takes part in the bidirectional algorithm.
+type Bidi struct {
+	Class       string         `json:"class"`
+	ClassName   string         `json:"class_name"`
+	Mirrored    bool           `json:"mirrored"`
+	MirrorGlyph *CodepointLink `json:"mirror_glyph,omitempty"`
+	Bracket     string         `json:"bracket,omitempty"`
+}
+
+// BidiCodepoint is one codepoint of a string run through the bidi algorithm.
+// Position is its index in logical order, VisualPosition in display order.
+type BidiCodepoint struct {
+	Position       int    `json:"position"`
+	VisualPosition int    `json:"visual_position"`
+	Codepoint      string `json:"codepoint"`
+	Character      string `json:"character"`
+	Class          string `json:"class"`
+	Level          int    `json:"level"`
+}
+
+// BidiRun is a sequence of codepoints resolved to the same embedding level.
+type BidiRun struct {
+	Text      string `json:"text"`
+	Direction string `json:"direction"`
+	Level     int    `json:"level"`
+	Start     int    `json:"start"`
+	End       int    `json:"end"`
+}
+
+// BidiParagraph is one paragraph of the input, the algorithm restarts after
+// every paragraph separator.
+type BidiParagraph struct {
+	Text       string          `json:"text"`
+	Direction  string          `json:"direction"`
+	Level      int             `json:"level"`
+	Visual     string          `json:"visual"`
+	Runs       []BidiRun       `json:"runs"`
+	Codepoints []BidiCodepoint `json:"codepoints"`
+}
+
+// BidiString is the result of the /bidi tool.
+type BidiString struct {
+	Input      string          `json:"input"`
+	Direction  string          `json:"direction"`
+	Paragraphs []BidiParagraph `json:"paragraphs"`
+}
+
+func getBidiClass(codepoint rune) string {
+	if ucd != nil && ucd.bidiClasses != nil {
+		if bidiClass, ok := findUCDRange(ucd.bidiClasses, codepoint); ok {
+			return bidiClass.value
+		}
+	}
+	// builtinBidiClasses also holds the defaults of unassigned codepoints,
+	// which UnicodeData.txt leaves out
+	if bidiClass, ok := findUCDRange(builtinBidiClasses, codepoint); ok {
+		return bidiClass.value
+	}
+	return "L"
+}
+
+func isBidiMirrored(codepoint rune) bool {
+	mirrored := builtinBidiMirrored
+	if ucd != nil && ucd.bidiMirrored != nil {
+		mirrored = ucd.bidiMirrored
+	}
+	_, ok := findUCDRange(mirrored, codepoint)
+	return ok
+}
+
+// bidiMirror returns the character with the mirrored glyph of codepoint, as
+// listed in BidiMirroring.txt. Some mirrored characters have none.
+func bidiMirror(codepoint rune) (rune, bool) {
+	mirrors := builtinMirrors
+	if ucd != nil && ucd.mirrors != nil {
+		mirrors = ucd.mirrors
+	}
+	mirror, ok := mirrors[codepoint]
+	return mirror, ok
+}
+
+func getBidi(codepoint rune) Bidi {
+	class := getBidiClass(codepoint)
+	data := Bidi{
+		Class:     class,
+		ClassName: bidiClassNames[class],
+		Mirrored:  isBidiMirrored(codepoint),
+	}
+
+	if mirror, ok := bidiMirror(codepoint); ok {
+		data.MirrorGlyph = &CodepointLink{fmt.Sprintf("%U", mirror), string(mirror)}
+	}
+
+	if bracket, ok := getBidiBracket(codepoint); ok {
+		data.Bracket = "close"
+		if bracket.opening {
+			data.Bracket = "open"
+		}
+	}
+
+	return data
+}
+
+// getBidiDirection reads the "dir" parameter of /bidi: auto, ltr or rtl.
+func getBidiDirection(request *http.Request) (string, error) {
+	direction := request.URL.Query().Get("dir")
+	switch direction {
+	case "":
+		return "auto", nil
+	case "auto", "ltr", "rtl":
+		return direction, nil
+	}
+	return "", &inputError{errMalformedInput, direction, []suggestion{
+		{"auto", "/bidi?dir=auto"},
+		{"left to right", "/bidi?dir=ltr"},
+		{"right to left", "/bidi?dir=rtl"},
+	}}
+}
+
+// bidiBracket is the Bidi_Paired_Bracket and Bidi_Paired_Bracket_Type of a
+// codepoint, from BidiBrackets.txt.
+type bidiBracket struct {
+	pair    rune
+	opening bool
+}
+
+func getBidiBracket(codepoint rune) (bidiBracket, bool) {
+	brackets := builtinBrackets
+	if ucd != nil && ucd.brackets != nil {
+		brackets = ucd.brackets
+	}
+	bracket, ok := brackets[codepoint]
+	return bracket, ok
+}
+
+// isBidiRemoved reports whether rule X9 removes a class from the algorithm.
+func isBidiRemoved(class string) bool {
+	switch class {
+	case "RLE", "LRE", "RLO", "LRO", "PDF", "BN":
+		return true
+	}
+	return false
+}
+
+func isBidiIsolateInitiator(class string) bool {
+	return class == "LRI" || class == "RLI" || class == "FSI"
+}
+
+// isBidiNeutral reports whether a class is neutral or isolate formatting,
+// NI of rules N1 and N2.
+func isBidiNeutral(class string) bool {
+	switch class {
+	case "B", "S", "WS", "ON", "LRI", "RLI", "FSI", "PDI":
+		return true
+	}
+	return false
+}
+
+// bidiDirectionOf is the strong direction a class counts as in rules N0 to
+// N2, numbers are right to left, and "" for other classes.
+func bidiDirectionOf(class string) string {
+	switch class {
+	case "L":
+		return "L"
+	case "R", "AL", "EN", "AN":
+		return "R"
+	}
+	return ""
+}
+
+// bidiLevelDirection is the direction of a level, L for even and R for odd.
+func bidiLevelDirection(level int) string {
+	if level%2 == 1 {
+		return "R"
+	}
+	return "L"
+}
+
+// bidiParagraphLevel applies rules P2 and P3: the first strong character
+// outside of isolates decides the direction.
+func bidiParagraphLevel(classes []string) int {
+	isolates := 0
+	for _, class := range classes {
+		switch class {
+		case "LRI", "RLI", "FSI":
+			isolates++
+		case "PDI":
+			if isolates > 0 {
+				isolates--
+			}
+		case "L":
+			if isolates == 0 {
+				return 0
+			}
+		case "R", "AL":
+			if isolates == 0 {
+				return 1
+			}
+		}
+	}
+	return 0
+}
+
+// bidiMaxDepth is the deepest embedding level of rule X1.
+const bidiMaxDepth = 125
+
+// bidiStatus is an entry of the directional status stack of rules X1 to X8.
+// override is L or R inside overrides and ON, for neutral, otherwise.
+type bidiStatus struct {
+	level    int
+	override string
+	isolate  bool
+}
+
+// bidiMatchingPDIs returns the position of the PDI closing every isolate
+// initiator, or -1 when it is never closed (BD9).
+func bidiMatchingPDIs(classes []string) []int {
+	matches := make([]int, len(classes))
+	var open []int
+	for position, class := range classes {
+		matches[position] = -1
+		switch {
+		case isBidiIsolateInitiator(class):
+			open = append(open, position)
+		case class == "PDI" && len(open) > 0:
+			matches[open[len(open)-1]] = position
+			open = open[:len(open)-1]
+		case class == "B":
+			open = open[:0]
+		}
+	}
+	return matches
+}
+
+// bidiExplicitLevels applies rules X1 to X8: the embedding level of every
+// codepoint, and its class once overrides are applied. Isolate initiators and
+// their PDI stay on the level around the isolate.
+func bidiExplicitLevels(classes []string, matches []int, paragraphLevel int) (levels []int, types []string) {
+	levels = make([]int, len(classes))
+	types = make([]string, len(classes))
+	stack := []bidiStatus{{paragraphLevel, "ON", false}}
+	overflowIsolates, overflowEmbeddings, validIsolates := 0, 0, 0
+
+	for position, class := range classes {
+		top := stack[len(stack)-1]
+		levels[position], types[position] = top.level, class
+
+		switch class {
+		case "RLE", "LRE", "RLO", "LRO", "RLI", "LRI", "FSI":
+			isolate := isBidiIsolateInitiator(class)
+			rightToLeft := class == "RLE" || class == "RLO" || class == "RLI"
+			if class == "FSI" {
+				end := matches[position]
+				if end < 0 {
+					end = len(classes)
+				}
+				rightToLeft = bidiParagraphLevel(classes[position+1:end]) == 1
+			}
+			if isolate && top.override != "ON" {
+				types[position] = top.override
+			}
+			status := bidiStatus{(top.level + 2) &^ 1, "ON", isolate}
+			if rightToLeft {
+				status.level = (top.level + 1) | 1
+			}
+			switch class {
+			case "RLO":
+				status.override = "R"
+			case "LRO":
+				status.override = "L"
+			}
+
+			switch {
+			case status.level <= bidiMaxDepth && overflowIsolates == 0 && overflowEmbeddings == 0:
+				if isolate {
+					validIsolates++
+				}
+				stack = append(stack, status)
+			case isolate:
+				overflowIsolates++
+			case overflowIsolates == 0:
+				overflowEmbeddings++
+			}
+		case "PDI":
+			if overflowIsolates > 0 {
+				overflowIsolates--
+			} else if validIsolates > 0 {
+				overflowEmbeddings = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates--
+			}
+			top = stack[len(stack)-1]
+			levels[position] = top.level
+			if top.override != "ON" {
+				types[position] = top.override
+			}
+		case "PDF":
+			if overflowIsolates == 0 {
+				if overflowEmbeddings > 0 {
+					overflowEmbeddings--
+				} else if !top.isolate && len(stack) > 1 {
+					stack = stack[:len(stack)-1]
+				}
+			}
+		case "B":
+			levels[position] = paragraphLevel
+		case "BN":
+		default:
+			if top.override != "ON" {
+				types[position] = top.override
+			}
+		}
+	}
+	return levels, types
+}
+
+// bidiSequence is an isolating run sequence (BD13): the positions of its
+// codepoints, their embedding level and the directions of sos and eos.
+type bidiSequence struct {
+	positions []int
+	level     int
+	sos, eos  string
+}
+
+// bidiSequences applies rule X10: the level runs of the codepoints left by
+// rule X9 are chained into isolating run sequences, a run ending with an
+// isolate initiator goes on with the run starting with its matching PDI.
+func bidiSequences(classes []string, levels []int, matches []int, paragraphLevel int) []bidiSequence {
+	var kept []int
+	for position, class := range classes {
+		if !isBidiRemoved(class) {
+			kept = append(kept, position)
+		}
+	}
+
+	var runs [][]int
+	for i, position := range kept {
+		if i == 0 || levels[position] != levels[kept[i-1]] {
+			runs = append(runs, nil)
+		}
+		runs[len(runs)-1] = append(runs[len(runs)-1], position)
+	}
+
+	// runStarting is the level run every matched PDI starts
+	runStarting := map[int]int{}
+	for i, run := range runs {
+		runStarting[run[0]] = i
+	}
+	isMatchedPDI := map[int]bool{}
+	for _, match := range matches {
+		if match >= 0 {
+			isMatchedPDI[match] = true
+		}
+	}
+
+	// before and after are the levels next to a position, ignoring the
+	// codepoints rule X9 removes
+	before := map[int]int{}
+	after := map[int]int{}
+	for i, position := range kept {
+		before[position], after[position] = paragraphLevel, paragraphLevel
+		if i > 0 {
+			before[position] = levels[kept[i-1]]
+		}
+		if i+1 < len(kept) {
+			after[position] = levels[kept[i+1]]
+		}
+	}
+	higher := func(a, b int) int {
+		if a > b {
+			return a
+		}
+		return b
+	}
+
+	var sequences []bidiSequence
+	for _, run := range runs {
+		if isMatchedPDI[run[0]] && classes[run[0]] == "PDI" {
+			continue
+		}
+		sequence := bidiSequence{level: levels[run[0]]}
+		for {
+			sequence.positions = append(sequence.positions, run...)
+			last := run[len(run)-1]
+			if !isBidiIsolateInitiator(classes[last]) || matches[last] < 0 {
+				break
+			}
+			next, ok := runStarting[matches[last]]
+			if !ok {
+				break
+			}
+			run = runs[next]
+		}
+
+		first, last := sequence.positions[0], sequence.positions[len(sequence.positions)-1]
+		sequence.sos = bidiLevelDirection(higher(sequence.level, before[first]))
+		end := after[last]
+		if isBidiIsolateInitiator(classes[last]) {
+			end = paragraphLevel
+		}
+		sequence.eos = bidiLevelDirection(higher(sequence.level, end))
+		sequences = append(sequences, sequence)
+	}
+	return sequences
+}
+
+// resolveWeak applies rules W1 to W7 to the classes of a sequence.
+func (sequence bidiSequence) resolveWeak(types []string) {
+	// W1: marks take the class of what they follow
+	previous := sequence.sos
+	for i, class := range types {
+		if class == "NSM" {
+			types[i] = previous
+			if isBidiIsolateInitiator(previous) || previous == "PDI" {
+				types[i] = "ON"
+			}
+		}
+		previous = types[i]
+	}
+
+	// W2 and W3: European numbers after Arabic letters are Arabic numbers
+	strong := sequence.sos
+	for i, class := range types {
+		switch class {
+		case "L", "R", "AL":
+			strong = class
+		case "EN":
+			if strong == "AL" {
+				types[i] = "AN"
+			}
+		}
+	}
+	for i, class := range types {
+		if class == "AL" {
+			types[i] = "R"
+		}
+	}
+
+	// W4: single separators between two numbers of the same kind
+	for i := 1; i+1 < len(types); i++ {
+		switch {
+		case types[i] == "ES" && types[i-1] == "EN" && types[i+1] == "EN":
+			types[i] = "EN"
+		case types[i] == "CS" && types[i-1] == "EN" && types[i+1] == "EN":
+			types[i] = "EN"
+		case types[i] == "CS" && types[i-1] == "AN" && types[i+1] == "AN":
+			types[i] = "AN"
+		}
+	}
+
+	// W5: terminators next to European numbers
+	for i := 0; i < len(types); i++ {
+		if types[i] != "ET" {
+			continue
+		}
+		end := i
+		for end < len(types) && types[end] == "ET" {
+			end++
+		}
+		if (i > 0 && types[i-1] == "EN") || (end < len(types) && types[end] == "EN") {
+			for j := i; j < end; j++ {
+				types[j] = "EN"
+			}
+		}
+		i = end - 1
+	}
+
+	// W6: other separators and terminators are neutral
+	for i, class := range types {
+		switch class {
+		case "ES", "ET", "CS":
+			types[i] = "ON"
+		}
+	}
+
+	// W7: European numbers after left to right text are left to right
+	strong = sequence.sos
+	for i, class := range types {
+		switch class {
+		case "L", "R":
+			strong = class
+		case "EN":
+			if strong == "L" {
+				types[i] = "L"
+			}
+		}
+	}
+}
+
+// bidiMaxBrackets is the depth of the bracket stack of BD16.
+const bidiMaxBrackets = 63
+
+// canonicalBracket maps the brackets that have a canonical equivalent among
+// the paired brackets to it, so the two pair up with either.
+func canonicalBracket(codepoint rune) rune {
+	switch codepoint {
+	case 0x2329:
+		return 0x3008
+	case 0x232A:
+		return 0x3009
+	}
+	return codepoint
+}
+
+// bracketPairs applies BD16: the positions in the sequence of the paired
+// brackets, in the order of their opening bracket.
+func (sequence bidiSequence) bracketPairs(runes []rune, types []string) [][2]int {
+	type openBracket struct {
+		pair     rune
+		position int
+	}
+	var stack []openBracket
+	var pairs [][2]int
+	for i, position := range sequence.positions {
+		bracket, ok := getBidiBracket(runes[position])
+		if !ok || types[i] != "ON" {
+			continue
+		}
+		if bracket.opening {
+			if len(stack) == bidiMaxBrackets {
+				break
+			}
+			stack = append(stack, openBracket{canonicalBracket(bracket.pair), i})
+			continue
+		}
+		for j := len(stack) - 1; j >= 0; j-- {
+			if stack[j].pair == canonicalBracket(runes[position]) {
+				pairs = append(pairs, [2]int{stack[j].position, i})
+				stack = stack[:j]
+				break
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i][0] < pairs[j][0] })
+	return pairs
+}
+
+// resolveBrackets applies rule N0: paired brackets take the embedding
+// direction when it is found between them, or the other direction when only
+// that is found between them and before them.
+func (sequence bidiSequence) resolveBrackets(runes []rune, types []string, original []string) {
+	embedding := bidiLevelDirection(sequence.level)
+	for _, pair := range sequence.bracketPairs(runes, types) {
+		found := ""
+		for i := pair[0] + 1; i < pair[1]; i++ {
+			if direction := bidiDirectionOf(types[i]); direction == embedding {
+				found = embedding
+				break
+			} else if direction != "" {
+				found = direction
+			}
+		}
+		if found == "" {
+			continue
+		}
+		if found != embedding {
+			context := sequence.sos
+			for i := pair[0] - 1; i >= 0; i-- {
+				if direction := bidiDirectionOf(types[i]); direction != "" {
+					context = direction
+					break
+				}
+			}
+			if context != found {
+				found = embedding
+			}
+		}
+
+		for _, i := range pair {
+			types[i] = found
+			// marks after a bracket follow it
+			for j := i + 1; j < len(types) && original[j] == "NSM"; j++ {
+				types[j] = found
+			}
+		}
+	}
+}
+
+// resolveNeutral applies rules N1 and N2: neutrals between two codepoints of
+// the same direction take it, others the embedding direction.
+func (sequence bidiSequence) resolveNeutral(types []string) {
+	for i := 0; i < len(types); i++ {
+		if !isBidiNeutral(types[i]) {
+			continue
+		}
+		end := i
+		for end < len(types) && isBidiNeutral(types[end]) {
+			end++
+		}
+		before, after := sequence.sos, sequence.eos
+		if i > 0 {
+			before = bidiDirectionOf(types[i-1])
+		}
+		if end < len(types) {
+			after = bidiDirectionOf(types[end])
+		}
+		direction := bidiLevelDirection(sequence.level)
+		if before == after {
+			direction = before
+		}
+		for j := i; j < end; j++ {
+			types[j] = direction
+		}
+		i = end - 1
+	}
+}
+
+// bidiResolve runs the bidirectional algorithm over a paragraph of runes and
+// their Bidi_Class. A negative paragraphLevel is found with rules P2 and P3.
+// It returns the levels of the codepoints after rule L1 and the paragraph
+// level. The codepoints rule X9 removes are given the level of the one before
+// them, so they go along with it when reordered.
+func bidiResolve(runes []rune, classes []string, paragraphLevel int) ([]int, int) {
+	if paragraphLevel < 0 {
+		paragraphLevel = bidiParagraphLevel(classes)
+	}
+	matches := bidiMatchingPDIs(classes)
+	levels, types := bidiExplicitLevels(classes, matches, paragraphLevel)
+
+	for _, sequence := range bidiSequences(classes, levels, matches, paragraphLevel) {
+		sequenceTypes := make([]string, len(sequence.positions))
+		original := make([]string, len(sequence.positions))
+		for i, position := range sequence.positions {
+			sequenceTypes[i], original[i] = types[position], types[position]
+		}
+		sequence.resolveWeak(sequenceTypes)
+		sequence.resolveBrackets(runes, sequenceTypes, original)
+		sequence.resolveNeutral(sequenceTypes)
+
+		// I1 and I2
+		for i, position := range sequence.positions {
+			switch class := sequenceTypes[i]; {
+			case levels[position]%2 == 0 && class == "R":
+				levels[position]++
+			case levels[position]%2 == 0 && (class == "AN" || class == "EN"):
+				levels[position] += 2
+			case levels[position]%2 == 1 && (class == "L" || class == "AN" || class == "EN"):
+				levels[position]++
+			}
+		}
+	}
+
+	for position, class := range classes {
+		if isBidiRemoved(class) {
+			levels[position] = paragraphLevel
+			if position > 0 {
+				levels[position] = levels[position-1]
+			}
+		}
+	}
+
+	// L1: separators, and the whitespace and formatting characters before
+	// them or at the end of the line, go back to the paragraph level
+	trailing := true
+	for position := len(classes) - 1; position >= 0; position-- {
+		switch class := classes[position]; {
+		case class == "B" || class == "S":
+			levels[position] = paragraphLevel
+			trailing = true
+		case class == "WS" || isBidiIsolateInitiator(class) || class == "PDI" || isBidiRemoved(class):
+			if trailing {
+				levels[position] = paragraphLevel
+			}
+		default:
+			trailing = false
+		}
+	}
+	return levels, paragraphLevel
+}
+
+// bidiVisualOrder applies rules L2 and L4: from the highest level down to
+// the lowest odd one, every sequence at that level or above is reversed, and
+// mirrored characters on odd levels are replaced by their mirror glyph.
+func bidiVisualOrder(runes []rune, levels []int) (visual []rune, order []int) {
+	order = make([]int, len(runes))
+	highest, lowestOdd := 0, 0
+	for i, level := range levels {
+		order[i] = i
+		if level > highest {
+			highest = level
+		}
+		if level%2 == 1 && (lowestOdd == 0 || level < lowestOdd) {
+			lowestOdd = level
+		}
+	}
+
+	for level := highest; level >= lowestOdd && level > 0; level-- {
+		for start := 0; start < len(order); start++ {
+			if levels[order[start]] < level {
+				continue
+			}
+			end := start
+			for end+1 < len(order) && levels[order[end+1]] >= level {
+				end++
+			}
+			for i, j := start, end; i < j; i, j = i+1, j-1 {
+				order[i], order[j] = order[j], order[i]
+			}
+			start = end
+		}
+	}
+
+	visual = make([]rune, len(order))
+	for i, position := range order {
+		visual[i] = runes[position]
+		if levels[position]%2 == 1 {
+			if mirror, ok := bidiMirror(runes[position]); ok {
+				visual[i] = mirror
+			}
+		}
+	}
+	return visual, order
+}
+
+func bidiDirectionName(level int) string {
+	if level%2 == 1 {
+		return "rtl"
+	}
+	return "ltr"
+}
+
+func getBidiParagraph(text string, direction string) BidiParagraph {
+	paragraphLevel := -1
+	switch direction {
+	case "ltr":
+		paragraphLevel = 0
+	case "rtl":
+		paragraphLevel = 1
+	}
+
+	runes := []rune(text)
+	classes := make([]string, len(runes))
+	for i, codepoint := range runes {
+		classes[i] = getBidiClass(codepoint)
+	}
+	levels, paragraphLevel := bidiResolve(runes, classes, paragraphLevel)
+
+	visual, order := bidiVisualOrder(runes, levels)
+	data := BidiParagraph{
+		Text:       string(runes),
+		Direction:  bidiDirectionName(paragraphLevel),
+		Level:      paragraphLevel,
+		Visual:     string(visual),
+		Runs:       []BidiRun{},
+		Codepoints: make([]BidiCodepoint, len(runes)),
+	}
+
+	for position, codepoint := range runes {
+		data.Codepoints[position] = BidiCodepoint{
+			Position:  position,
+			Codepoint: fmt.Sprintf("%U", codepoint),
+			Character: string(codepoint),
+			Class:     classes[position],
+			Level:     levels[position],
+		}
+	}
+	for visualPosition, position := range order {
+		data.Codepoints[position].VisualPosition = visualPosition
+	}
+
+	for start := 0; start < len(runes); {
+		end := start
+		for end+1 < len(runes) && levels[end+1] == levels[start] {
+			end++
+		}
+		data.Runs = append(data.Runs, BidiRun{
+			Text:      string(runes[start : end+1]),
+			Direction: bidiDirectionName(levels[start]),
+			Level:     levels[start],
+			Start:     start,
+			End:       end,
+		})
+		start = end + 1
+	}
+
+	return data
+}
+
+func getBidiString(input string, direction string) BidiString {
+	data := BidiString{
+		Input:      input,
+		Direction:  direction,
+		Paragraphs: []BidiParagraph{},
+	}
+
+	// every paragraph separator ends a paragraph, and stays part of it
+	for rest := input; rest != ""; {
+		end := len(rest)
+		for i, codepoint := range rest {
+			if getBidiClass(codepoint) == "B" {
+				end = i + utf8.RuneLen(codepoint)
+				// CR LF is a single separator
+				if codepoint == '\r' && strings.HasPrefix(rest[end:], "\n") {
+					end++
+				}
+				break
+			}
+		}
+
+		data.Paragraphs = append(data.Paragraphs, getBidiParagraph(rest[:end], direction))
+		rest = rest[end:]
+	}
+
+	return data
+}
+
+func serveBidiJSON(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	input, err := getStringInput(request)
+	if err != nil {
+		serveJSONError(writer, request, err, timer)
+		return
+	}
+	direction, err := getBidiDirection(request)
+	if err != nil {
+		serveJSONError(writer, request, err, timer)
+		return
+	}
+	writer = setJSONHeaders(writer)
+	serveJSON(writer, request, getBidiString(input, direction), timer)
+}
+
+func serveBidi(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	writer.Header().Add("Vary", "Accept")
+	if wantsJSON(request) {
+		serveBidiJSON(writer, request, timer)
+		return
+	}
+
+	input, err := getStringInput(request)
+	if err != nil {
+		serveError(writer, request, err, timer)
+		return
+	}
+	direction, err := getBidiDirection(request)
+	if err != nil {
+		serveError(writer, request, err, timer)
+		return
+	}
+	writer = setHeaders(writer)
+
+	data := struct {
+		BidiString
+		UnicodeVersion string
+	}{
+		BidiString:     getBidiString(input, direction),
+		UnicodeVersion: unicodeVersion(),
+	}
+
+	templateFiles := []string{
+		"./template/base.template.html",
+		"./template/bidi.template.html",
+	}
+
+	serveFilesFromTemplate(writer, request, templateFiles, data, timer)
+}
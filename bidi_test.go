@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func bidiStringLevels(t *testing.T, input, direction string) []int {
+	t.Helper()
+	var levels []int
+	for _, paragraph := range getBidiString(input, direction).Paragraphs {
+		for _, codepoint := range paragraph.Codepoints {
+			levels = append(levels, codepoint.Level)
+		}
+	}
+	return levels
+}
+
+func TestBidiLevels(t *testing.T) {
+	tests := []struct {
+		input     string
+		direction string
+		levels    []int
+	}{
+		{"⁧abc⁩ א", "auto", []int{1, 4, 4, 4, 1, 1, 1}},
+		{"⁧abc⁩ א", "rtl", []int{1, 4, 4, 4, 1, 1, 1}},
+		{"⁧abc⁩ א", "ltr", []int{0, 2, 2, 2, 0, 0, 1}},
+		{"⁨א⁩ a", "rtl", []int{1, 3, 1, 1, 2}},
+		{"a‫b‬c", "auto", []int{0, 0, 2, 2, 0}},
+		{"א 123 ב", "auto", []int{1, 1, 2, 2, 2, 1, 1}},
+		{"abc\nא x", "rtl", []int{2, 2, 2, 1, 1, 1, 2}},
+		// numbers inside embeddings and isolates
+		{"a‫1‬", "auto", []int{0, 0, 2, 0}},
+		{"a‪א 1‬", "auto", []int{0, 0, 3, 3, 4, 0}},
+		{"א⁦1 2⁩", "auto", []int{1, 1, 2, 2, 2, 1}},
+		{"a⁧b 1⁩ c", "auto", []int{0, 0, 2, 2, 2, 0, 0, 0}},
+		{"a‮b 1‬", "auto", []int{0, 0, 1, 1, 1, 0}},
+		// W2: European numbers after Arabic letters are Arabic numbers, W4
+		// joins numbers over a single separator
+		{"ع 12", "ltr", []int{1, 1, 2, 2}},
+		{"a 1.2 b", "rtl", []int{2, 2, 2, 2, 2, 2, 2}},
+		{"$12 א", "rtl", []int{2, 2, 2, 1, 1}},
+		// N0: brackets take the direction of what they hold
+		{"א(a)ב", "auto", []int{1, 1, 2, 1, 1}},
+		{"a(א)b", "auto", []int{0, 0, 1, 0, 0}},
+		{"א(a)", "ltr", []int{1, 0, 0, 0}},
+		{"(א)", "auto", []int{1, 1, 1}},
+		{"a〈א〉", "rtl", []int{2, 1, 1, 1}},
+		// U+2329 pairs with U+3009, its canonical equivalent's pair
+		{"a\u2329א\u3009", "rtl", []int{2, 1, 1, 1}},
+		// L1: trailing whitespace and tabs go back to the paragraph level
+		{"א b\tג ", "ltr", []int{1, 0, 0, 0, 1, 0}},
+		{"a ", "rtl", []int{2, 1}},
+	}
+	for _, test := range tests {
+		if levels := bidiStringLevels(t, test.input, test.direction); !reflect.DeepEqual(levels, test.levels) {
+			t.Errorf("%q %s: got levels %v, want %v", test.input, test.direction, levels, test.levels)
+		}
+	}
+}
+
+func TestBidiVisual(t *testing.T) {
+	tests := []struct {
+		input     string
+		direction string
+		visual    string
+	}{
+		{"abc אבג", "auto", "abc גבא"},
+		{"אבג abc", "auto", "abc גבא"},
+		{"א(ב)", "auto", "(ב)א"},
+		{"א 12 ב", "auto", "ב 12 א"},
+	}
+	for _, test := range tests {
+		var visual []string
+		for _, paragraph := range getBidiString(test.input, test.direction).Paragraphs {
+			visual = append(visual, paragraph.Visual)
+		}
+		if got := strings.Join(visual, ""); got != test.visual {
+			t.Errorf("%q %s: got %q, want %q", test.input, test.direction, got, test.visual)
+		}
+	}
+}
+
+// bidiTestResult resolves runes and classes the way the test files expect:
+// the levels, "x" for the codepoints rule X9 removes, and the visual order
+// without them.
+func bidiTestResult(runes []rune, classes []string, paragraphLevel int) (levels, order []string, level int) {
+	resolved, level := bidiResolve(runes, classes, paragraphLevel)
+	for position, class := range classes {
+		if isBidiRemoved(class) {
+			levels = append(levels, "x")
+		} else {
+			levels = append(levels, strconv.Itoa(resolved[position]))
+		}
+	}
+	_, visual := bidiVisualOrder(runes, resolved)
+	for _, position := range visual {
+		if !isBidiRemoved(classes[position]) {
+			order = append(order, strconv.Itoa(position))
+		}
+	}
+	return levels, order, level
+}
+
+func openGzip(t *testing.T, path string) *bufio.Scanner {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	reader, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(nil, 1<<20)
+	return scanner
+}
+
+// TestBidiCharacterConformance runs BidiCharacterTest.txt: codepoints,
+// paragraph direction (0 left to right, 1 right to left, 2 auto), resolved
+// paragraph level, levels and visual order.
+func TestBidiCharacterConformance(t *testing.T) {
+	scanner := openGzip(t, "testdata/BidiCharacterTest.txt.gz")
+	cases, failures := 0, 0
+	for scanner.Scan() {
+		line := scanner.Text()
+		fields := strings.Split(line, ";")
+		if strings.HasPrefix(line, "#") || len(fields) != 5 {
+			continue
+		}
+		var runes []rune
+		var classes []string
+		for _, field := range strings.Fields(fields[0]) {
+			codepoint, err := strconv.ParseUint(field, 16, 32)
+			if err != nil {
+				t.Fatalf("%s: %v", line, err)
+			}
+			runes = append(runes, rune(codepoint))
+			classes = append(classes, getBidiClass(rune(codepoint)))
+		}
+		paragraphLevel := map[string]int{"0": 0, "1": 1, "2": -1}[fields[1]]
+
+		cases++
+		levels, order, level := bidiTestResult(runes, classes, paragraphLevel)
+		if strconv.Itoa(level) != fields[2] ||
+			strings.Join(levels, " ") != fields[3] || strings.Join(order, " ") != fields[4] {
+			if failures++; failures <= 10 {
+				t.Errorf("%s: got level %d, levels %v, order %v", line, level, levels, order)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if failures > 0 || cases == 0 {
+		t.Errorf("%d of %d cases fail", failures, cases)
+	}
+}
+
+// TestBidiConformance runs BidiTest.txt: sequences of classes with a bit set
+// of paragraph directions (1 auto, 2 left to right, 4 right to left), under
+// the @Levels and @Reorder they resolve to.
+func TestBidiConformance(t *testing.T) {
+	scanner := openGzip(t, "testdata/BidiTest.txt.gz")
+	var levels, order string
+	cases, failures := 0, 0
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		switch {
+		case strings.HasPrefix(line, "@Levels:"):
+			levels = strings.Join(strings.Fields(strings.TrimPrefix(line, "@Levels:")), " ")
+			continue
+		case strings.HasPrefix(line, "@Reorder:"):
+			order = strings.Join(strings.Fields(strings.TrimPrefix(line, "@Reorder:")), " ")
+			continue
+		case strings.HasPrefix(line, "@") || strings.TrimSpace(line) == "":
+			continue
+		}
+
+		input, bits, ok := strings.Cut(line, ";")
+		if !ok {
+			t.Fatalf("%s: no bit set", line)
+		}
+		set, err := strconv.ParseUint(strings.TrimSpace(bits), 16, 8)
+		if err != nil {
+			t.Fatalf("%s: %v", line, err)
+		}
+		classes := strings.Fields(input)
+		runes := make([]rune, len(classes))
+		for bit, paragraphLevel := range map[uint64]int{1: -1, 2: 0, 4: 1} {
+			if set&bit == 0 {
+				continue
+			}
+			cases++
+			gotLevels, gotOrder, _ := bidiTestResult(runes, classes, paragraphLevel)
+			if strings.Join(gotLevels, " ") != levels || strings.Join(gotOrder, " ") != order {
+				if failures++; failures <= 10 {
+					t.Errorf("%s, paragraph level %d: got levels %v and order %v, want %s and %s", input, paragraphLevel, gotLevels, gotOrder, levels, order)
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if failures > 0 || cases == 0 {
+		t.Errorf("%d of %d cases fail", failures, cases)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type blockLink struct {
+	Name string
+	Slug string
+}
+
+func blockList() []ucdRange {
+	if ucd != nil && ucd.blocks != nil {
+		return ucd.blocks
+	}
+	return builtinBlocks
+}
+
+// blockSlug turns a block name into the form used in /block URLs, i.e.
+// "Latin-1 Supplement" becomes "latin_1_supplement".
+func blockSlug(name string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "_", "-", "_").Replace(name))
+}
+
+// looseBlockName applies the UAX #44 loose matching rule for block names:
+// case, whitespace, hyphens and underscores are ignored.
+func looseBlockName(name string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(name))
+}
+
+func getBlock(codepoint rune) (block ucdRange, ok bool) {
+	return findUCDRange(blockList(), codepoint)
+}
+
+func lookupBlock(route string) (block ucdRange, ok bool) {
+	route = looseBlockName(route)
+	for _, block := range blockList() {
+		if looseBlockName(block.value) == route {
+			return block, true
+		}
+	}
+	return ucdRange{}, false
+}
+
+func getBlockLinks() (links []blockLink) {
+	for _, block := range blockList() {
+		links = append(links, blockLink{block.value, blockSlug(block.value)})
+	}
+	return
+}
+
+// getBlockRangeTable returns the assigned codepoints of a block, so that
+// reserved cells are rendered as invalid by generateTableHTML.
+func getBlockRangeTable(block ucdRange) *unicode.RangeTable {
+	var assigned []ucdRange
+	for codepoint := block.lo; codepoint <= block.hi; codepoint++ {
+		if getGeneralCategory(codepoint) == "Cn" {
+			continue
+		}
+		if len(assigned) > 0 && assigned[len(assigned)-1].hi == codepoint-1 {
+			assigned[len(assigned)-1].hi = codepoint
+			continue
+		}
+		assigned = append(assigned, ucdRange{lo: codepoint, hi: codepoint})
+	}
+	return newRangeTable(assigned)
+}
+
+func serveBlock(writer http.ResponseWriter, request *http.Request, route string, timer time.Time) {
+	block, ok := lookupBlock(route)
+	if !ok {
+		serveError(writer, request, &inputError{errUnknownRange, route, rangeSuggestions(route)}, timer)
+		return
+	}
+
+	slug := blockSlug(block.value)
+	serveRangeTable(writer, request, block.value, "/api/v1/block/"+slug, getBlockRangeTable(block), timer)
+}
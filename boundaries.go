@@ -0,0 +1,192 @@
+package main
+
+import "unicode"
+
+// The grapheme cluster and word boundaries of UAX #29, found with the same
+// Grapheme_Cluster_Break and Word_Break tables the segment page shows.
+// Sentences and line breaks are left to uniseg.
+
+func getIndicConjunctBreak(codepoint rune) string {
+	breaks := builtinIndicConjunctBreak
+	if ucd != nil && ucd.indicConjunctBreaks != nil {
+		breaks = ucd.indicConjunctBreaks
+	}
+	if r, ok := findUCDRange(breaks, codepoint); ok {
+		return r.value
+	}
+	return "None"
+}
+
+func isExtendedPictographic(codepoint rune) bool {
+	table, ok := emojiPropertyTables()["Extended_Pictographic"]
+	return ok && unicode.Is(table, codepoint)
+}
+
+// graphemeBoundaries reports for every codepoint of runes whether a grapheme
+// cluster starts with it, rules GB1 to GB999.
+func graphemeBoundaries(runes []rune) []bool {
+	boundaries := make([]bool, len(runes))
+	// emoji is 1 after \p{ExtPict} Extend*, 2 after \p{ExtPict} Extend* ZWJ;
+	// conjunct is 1 after an InCB=Consonant followed by InCB=Extend or
+	// Linker, 2 once one of them is a Linker; regionalIndicators counts the
+	// RI in a row
+	emoji, conjunct, regionalIndicators := 0, 0, 0
+	var previous string
+	for i, codepoint := range runes {
+		current := getGraphemeClusterBreak(codepoint)
+		incb := getIndicConjunctBreak(codepoint)
+		extendedPictographic := isExtendedPictographic(codepoint)
+
+		if i == 0 {
+			boundaries[i] = true
+		} else {
+			boundaries[i] = graphemeBoundary(previous, current, emoji == 2 && extendedPictographic,
+				conjunct == 2 && incb == "Consonant", regionalIndicators%2 == 1)
+		}
+
+		switch {
+		case extendedPictographic:
+			emoji = 1
+		case emoji == 1 && current == "ZWJ":
+			emoji = 2
+		case emoji == 1 && current == "Extend":
+		default:
+			emoji = 0
+		}
+
+		switch {
+		case incb == "Consonant":
+			conjunct = 1
+		case conjunct > 0 && incb == "Linker":
+			conjunct = 2
+		case conjunct > 0 && incb == "Extend":
+		default:
+			conjunct = 0
+		}
+
+		if current == "Regional_Indicator" {
+			regionalIndicators++
+		} else {
+			regionalIndicators = 0
+		}
+		previous = current
+	}
+	return boundaries
+}
+
+// graphemeBoundary applies the rules between two codepoints, given whether
+// GB11, GB9c and GB12/GB13 keep them together from what came before.
+func graphemeBoundary(previous, current string, emojiZWJ, conjunct, oddRegionalIndicators bool) bool {
+	isControl := func(value string) bool {
+		return value == "Control" || value == "CR" || value == "LF"
+	}
+	switch {
+	case previous == "CR" && current == "LF":
+		return false
+	case isControl(previous), isControl(current):
+		return true
+	case previous == "L" && (current == "L" || current == "V" || current == "LV" || current == "LVT"):
+		return false
+	case (previous == "LV" || previous == "V") && (current == "V" || current == "T"):
+		return false
+	case (previous == "LVT" || previous == "T") && current == "T":
+		return false
+	case current == "Extend" || current == "ZWJ" || current == "SpacingMark" || previous == "Prepend":
+		return false
+	case conjunct, emojiZWJ:
+		return false
+	case previous == "Regional_Indicator" && current == "Regional_Indicator" && oddRegionalIndicators:
+		return false
+	}
+	return true
+}
+
+// wordBoundaries reports for every codepoint of runes whether a word starts
+// with it, rules WB1 to WB999.
+func wordBoundaries(runes []rune) []bool {
+	values := make([]string, len(runes))
+	for i, codepoint := range runes {
+		values[i] = getWordBreak(codepoint)
+	}
+	isNewline := func(i int) bool {
+		return values[i] == "Newline" || values[i] == "CR" || values[i] == "LF"
+	}
+	// WB4 attaches Extend, Format and ZWJ to the codepoint before them,
+	// unless that is a line break or there is none
+	ignored := func(i int) bool {
+		return i > 0 && !isNewline(i-1) && (values[i] == "Extend" || values[i] == "Format" || values[i] == "ZWJ")
+	}
+	before := func(i int) int {
+		for i--; i >= 0 && ignored(i); i-- {
+		}
+		return i
+	}
+	after := func(i int) int {
+		for i++; i < len(runes) && ignored(i); i++ {
+		}
+		return i
+	}
+	value := func(i int) string {
+		if i < 0 || i >= len(runes) {
+			return ""
+		}
+		return values[i]
+	}
+	isAHLetter := func(value string) bool {
+		return value == "ALetter" || value == "Hebrew_Letter"
+	}
+	isMidNumLetQ := func(value string) bool {
+		return value == "MidNumLet" || value == "Single_Quote"
+	}
+
+	boundaries := make([]bool, len(runes))
+	for i := range runes {
+		if i == 0 {
+			boundaries[i] = true
+			continue
+		}
+		raw, current := values[i-1], values[i]
+		switch {
+		case raw == "CR" && current == "LF":
+			continue
+		case isNewline(i-1) || isNewline(i):
+			boundaries[i] = true
+			continue
+		case raw == "ZWJ" && isExtendedPictographic(runes[i]):
+			continue
+		case raw == "WSegSpace" && current == "WSegSpace":
+			continue
+		case ignored(i):
+			continue
+		}
+
+		p := before(i)
+		previous, beforePrevious, next := value(p), value(before(p)), value(after(i))
+		switch {
+		case isAHLetter(previous) && isAHLetter(current):
+		case isAHLetter(previous) && (current == "MidLetter" || isMidNumLetQ(current)) && isAHLetter(next):
+		case isAHLetter(beforePrevious) && (previous == "MidLetter" || isMidNumLetQ(previous)) && isAHLetter(current):
+		case previous == "Hebrew_Letter" && current == "Single_Quote":
+		case previous == "Hebrew_Letter" && current == "Double_Quote" && next == "Hebrew_Letter":
+		case beforePrevious == "Hebrew_Letter" && previous == "Double_Quote" && current == "Hebrew_Letter":
+		case previous == "Numeric" && current == "Numeric":
+		case isAHLetter(previous) && current == "Numeric":
+		case previous == "Numeric" && isAHLetter(current):
+		case beforePrevious == "Numeric" && (previous == "MidNum" || isMidNumLetQ(previous)) && current == "Numeric":
+		case previous == "Numeric" && (current == "MidNum" || isMidNumLetQ(current)) && next == "Numeric":
+		case previous == "Katakana" && current == "Katakana":
+		case (isAHLetter(previous) || previous == "Numeric" || previous == "Katakana" || previous == "ExtendNumLet") && current == "ExtendNumLet":
+		case previous == "ExtendNumLet" && (isAHLetter(current) || current == "Numeric" || current == "Katakana"):
+		case previous == "Regional_Indicator" && current == "Regional_Indicator":
+			// WB15 and WB16 pair them up
+			count := 0
+			for j := p; j >= 0 && values[j] == "Regional_Indicator"; j = before(j) {
+				count++
+			}
+			boundaries[i] = count%2 == 0
+		default:
+			boundaries[i] = true
+		}
+	}
+	return boundaries
+}
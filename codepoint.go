@@ -1,127 +1,306 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"html"
 	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 	"unicode"
-
-	"golang.org/x/text/unicode/runenames"
+	"unicode/utf16"
+	"unicode/utf8"
 )
 
-func serveCodepoint(writer http.ResponseWriter, request *http.Request, route string, timer time.Time) {
-	writer = setHeaders(writer)
+// CodepointData is everything unicode.click knows about a single codepoint.
+// It backs both rune.template.html and the /api/v1/cp JSON document, so the
+// two views are always built from the same values.
+type CodepointData struct {
+	CodepointHexAsString string `json:"codepoint"`
+	LitRune              string `json:"character"`
+	RuneName             string `json:"name"`
+	UnicodeVersion       string `json:"unicode_version"`
+
+	Aliases        []NameAlias     `json:"aliases"`
+	NamedSequences []NamedSequence `json:"named_sequences"`
+
+	Block     string `json:"block"`
+	BlockSlug string `json:"-"`
+	Age       string `json:"age,omitempty"`
+
+	Scripts          []string `json:"scripts"`
+	ScriptExtensions []string `json:"script_extensions"`
+	// UsedInOtherScripts is set when ScriptExtensions names other scripts
+	// than Scripts.
+	UsedInOtherScripts bool     `json:"-"`
+	Properties         []string `json:"properties"`
+
+	MajorCategories string `json:"major_categories"`
+	Categories      string `json:"categories"`
+	MajCatLiteral   string `json:"major_category"`
+	CatLiteral      string `json:"category"`
+
+	IsControl bool `json:"is_control"`
+	IsDigit   bool `json:"is_digit"`
+	IsGraphic bool `json:"is_graphic"`
+	IsLetter  bool `json:"is_letter"`
+	IsLower   bool `json:"is_lower"`
+	IsMark    bool `json:"is_mark"`
+	IsNumber  bool `json:"is_number"`
+	IsPrint   bool `json:"is_print"`
+	IsPunct   bool `json:"is_punct"`
+	IsSpace   bool `json:"is_space"`
+	IsSymbol  bool `json:"is_symbol"`
+	IsTitle   bool `json:"is_title"`
+	IsUpper   bool `json:"is_upper"`
+
+	AsUppercase string `json:"uppercase"`
+	AsLowercase string `json:"lowercase"`
+	AsTitlecase string `json:"titlecase"`
 
-	var codepoint rune
+	UppercaseCodepoint string `json:"-"`
+	LowercaseCodepoint string `json:"-"`
+	TitlecaseCodepoint string `json:"-"`
 
-	if strings.ContainsRune(route, '+') {
+	HasDifferentCase bool `json:"has_different_case"`
+
+	Encodings     Encodings       `json:"encodings"`
+	Normalization Normalization   `json:"normalization"`
+	Bidi          Bidi            `json:"bidi"`
+	Width         Width           `json:"width"`
+	Confusables   *Confusables    `json:"confusables,omitempty"`
+	Emoji         *CodepointEmoji `json:"emoji,omitempty"`
+	Segmentation  Segmentation    `json:"segmentation"`
+	Neighbours    Neighbours      `json:"neighbours"`
+}
+
+// codepointNotations are the ways of writing a codepoint that /cp accepts
+// besides U+hex, a literal character and a character name. A leading
+// backslash is optional because browsers turn it into a slash.
+var codepointNotations = []struct {
+	pattern *regexp.Regexp
+	base    int
+}{
+	{regexp.MustCompile(`^0[xX]([0-9A-Fa-f]+)$`), 16},
+	{regexp.MustCompile(`^[\\/]?u\{([0-9A-Fa-f]+)\}$`), 16},
+	{regexp.MustCompile(`^[\\/]?u([0-9A-Fa-f]{4})$`), 16},
+	{regexp.MustCompile(`^[\\/]?U([0-9A-Fa-f]{8})$`), 16},
+	{regexp.MustCompile(`^([0-9]+)$`), 10},
+}
+
+var (
+	surrogatePairNotation = regexp.MustCompile(`^[\\/]?u([dD][89abAB][0-9A-Fa-f]{2})[\\/]u([dD][c-fC-F][0-9A-Fa-f]{2})$`)
+	entityNotation        = regexp.MustCompile(`^&#?[0-9A-Za-z]+;?$`)
+)
+
+// parseCodepointRoute reads a codepoint written in any of the notations /cp
+// accepts: U+1F600, a literal character, 0x1F600, \u{1F600}, \U0001F600,
+// \uD83D\uDE00, &#128512;, &#x1F600;, &hearts;, decimal 128512 or a
+// character name or alias. A single character is always taken literally, so
+// /cp/7 is DIGIT SEVEN and /cp/55 is U+0037.
+func parseCodepointRoute(route string) (codepoint rune, err error) {
+	switch {
+	case utf8.RuneCountInString(route) == 1:
+		var size int
+		codepoint, size = utf8.DecodeRuneInString(route)
+		if codepoint == utf8.RuneError && size <= 1 {
+			return 0, &inputError{errMalformedInput, route, nil}
+		}
+	case len(route) > 2 && strings.EqualFold(route[:2], "U+"):
 		// convert from U+ prefix (i.e. U+0061) to rune
-		tempRoute := strings.SplitAfter(route, "+")
-		codepointInt64, _ := strconv.ParseInt(tempRoute[1], 16, 0)
-		codepoint = rune(codepointInt64)
-	} else {
-		// convert first character to rune
-		runeArray := []rune(route)
-		codepoint = rune(runeArray[0])
-	}
-
-	// check if codepoint exists, do something else if not
-	if codepoint > unicode.MaxRune || codepoint < 0 || codepoint > 2147483647 {
-		// TODO: create a dedicated 404 page with a JS-based automatic redirect
-		http.Redirect(writer, request, "https://unicode.click/", http.StatusMovedPermanently)
-		return
+		codepoint, err = parseCodepointNumber(route, route[2:], 16)
+		if err != nil {
+			return 0, err
+		}
+	default:
+		codepoint, err = parseCodepointNotation(route)
+		if err != nil {
+			return 0, err
+		}
 	}
 
-	majorCategoryLiteral, categoryLiteral, categories, majorCategories := getCategoryData(codepoint)
+	return codepoint, validateCodepoint(codepoint, route)
+}
 
-	var scripts []string
-	for scriptName, scriptRangeTable := range unicode.Scripts {
-		if unicode.Is(scriptRangeTable, codepoint) {
-			scripts = append(scripts, scriptName)
+func parseCodepointNumber(route string, digits string, base int) (rune, error) {
+	codepointInt64, err := strconv.ParseInt(digits, base, 64)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, validateCodepoint(-1, route)
 		}
+		return 0, &inputError{errMalformedInput, route, []suggestion{
+			{"U+ is followed by hexadecimal digits, i.e. U+0061", "/cp/U+0061"},
+		}}
+	}
+	if codepointInt64 > unicode.MaxRune {
+		return 0, validateCodepoint(-1, route)
+	}
+	return rune(codepointInt64), nil
+}
+
+func parseCodepointNotation(route string) (rune, error) {
+	for _, notation := range codepointNotations {
+		if match := notation.pattern.FindStringSubmatch(route); match != nil {
+			return parseCodepointNumber(route, match[1], notation.base)
+		}
+	}
+
+	if match := surrogatePairNotation.FindStringSubmatch(route); match != nil {
+		high, _ := strconv.ParseUint(match[1], 16, 16)
+		low, _ := strconv.ParseUint(match[2], 16, 16)
+		return utf16.DecodeRune(rune(high), rune(low)), nil
+	}
+
+	if entityNotation.MatchString(route) {
+		if text := html.UnescapeString(route); text != route && utf8.RuneCountInString(text) == 1 {
+			codepoint, _ := utf8.DecodeRuneInString(text)
+			return codepoint, nil
+		}
+	}
+
+	// a character name or alias, i.e. /cp/ZWJ
+	if lookup, ok := lookupName(route); ok && utf8.RuneCountInString(lookup.Text) == 1 {
+		codepoint, _ := utf8.DecodeRuneInString(lookup.Text)
+		return codepoint, nil
 	}
 
-	var properties []string
-	for propertyName, propertyRangeTable := range unicode.Properties {
+	return 0, &inputError{errMalformedInput, route, []suggestion{
+		{"search character names", "/search?" + url.Values{"q": {route}}.Encode()},
+		{"inspect every character", "/inspect?" + url.Values{"s": {route}}.Encode()},
+		{"U+ is followed by hexadecimal digits, i.e. U+0061", "/cp/U+0061"},
+	}}
+}
+
+func getCodepointData(codepoint rune) CodepointData {
+	majorCategoryLiteral, categoryLiteral, categories, majorCategories := getCategoryData(codepoint)
+
+	properties := []string{}
+	for propertyName, propertyRangeTable := range propertyTables() {
 		if unicode.Is(propertyRangeTable, codepoint) {
 			properties = append(properties, propertyName)
 		}
 	}
 
-	data := struct {
-		CodepointHexAsString string
-		LitRune              string
-		RuneName             string
-		UnicodeVersion       string
-
-		Scripts    string
-		Properties []string
-
-		MajorCategories string
-		Categories      string
-		MajCatLiteral   string
-		CatLiteral      string
-
-		IsControl bool
-		IsDigit   bool
-		IsGraphic bool
-		IsLetter  bool
-		IsLower   bool
-		IsMark    bool
-		IsNumber  bool
-		IsPrint   bool
-		IsPunct   bool
-		IsSpace   bool
-		IsSymbol  bool
-		IsTitle   bool
-		IsUpper   bool
-
-		AsUppercase string
-		AsLowercase string
-		AsTitlecase string
-
-		HasDifferentCase bool
-	}{
+	// map iteration order is random, keep the output stable
+	sort.Strings(properties)
+
+	scripts := getScripts(codepoint)
+	scriptExtensions := getScriptExtensions(codepoint)
+
+	blockName := "No_Block"
+	if block, ok := getBlock(codepoint); ok {
+		blockName = block.value
+	}
+	mapping := getCaseMapping(codepoint)
+
+	return CodepointData{
 		CodepointHexAsString: fmt.Sprintf("%U", codepoint),
 		LitRune:              string(codepoint),
-		RuneName:             runenames.Name(codepoint),
-		UnicodeVersion:       unicode.Version,
+		RuneName:             runeName(codepoint),
+		UnicodeVersion:       unicodeVersion(),
+
+		Aliases:        getNameAliases(codepoint),
+		NamedSequences: getNamedSequences(codepoint),
 
-		Scripts:    strings.Join(scripts, ", "),
-		Properties: properties,
+		Block:     blockName,
+		BlockSlug: blockSlug(blockName),
+		Age:       getAge(codepoint),
+
+		Scripts:            scripts,
+		ScriptExtensions:   scriptExtensions,
+		UsedInOtherScripts: strings.Join(scripts, " ") != strings.Join(scriptExtensions, " "),
+		Properties:         properties,
 
 		MajCatLiteral:   majorCategoryLiteral,
 		CatLiteral:      categoryLiteral,
 		MajorCategories: strings.Join(majorCategories, ", "),
 		Categories:      strings.Join(categories, ", "),
 
-		IsControl: unicode.IsControl(codepoint),
-		IsDigit:   unicode.IsDigit(codepoint),
-		IsGraphic: unicode.IsGraphic(codepoint),
-		IsLetter:  unicode.IsLetter(codepoint),
-		IsLower:   unicode.IsLower(codepoint),
-		IsMark:    unicode.IsMark(codepoint),
-		IsNumber:  unicode.IsNumber(codepoint),
-		IsPrint:   unicode.IsPrint(codepoint),
-		IsPunct:   unicode.IsPunct(codepoint),
-		IsSpace:   unicode.IsSpace(codepoint),
-		IsSymbol:  unicode.IsSymbol(codepoint),
-		IsTitle:   unicode.IsTitle(codepoint),
-		IsUpper:   unicode.IsUpper(codepoint),
+		IsControl: inCategories(codepoint, "Cc"),
+		IsDigit:   inCategories(codepoint, "Nd"),
+		IsGraphic: inCategories(codepoint, "L", "M", "N", "P", "S", "Zs"),
+		IsLetter:  inCategories(codepoint, "L"),
+		IsLower:   inCategories(codepoint, "Ll"),
+		IsMark:    inCategories(codepoint, "M"),
+		IsNumber:  inCategories(codepoint, "N"),
+		IsPrint:   isPrint(codepoint),
+		IsPunct:   inCategories(codepoint, "P"),
+		IsSpace:   hasProperty(codepoint, "White_Space"),
+		IsSymbol:  inCategories(codepoint, "S"),
+		IsTitle:   inCategories(codepoint, "Lt"),
+		IsUpper:   inCategories(codepoint, "Lu"),
+
+		AsUppercase: string(mapping.upper),
+		AsLowercase: string(mapping.lower),
+		AsTitlecase: string(mapping.title),
+
+		UppercaseCodepoint: fmt.Sprintf("%U", mapping.upper),
+		LowercaseCodepoint: fmt.Sprintf("%U", mapping.lower),
+		TitlecaseCodepoint: fmt.Sprintf("%U", mapping.title),
+
+		HasDifferentCase: inCategories(codepoint, "Lu", "Ll", "Lt"),
 
-		AsUppercase: string(unicode.ToUpper(codepoint)),
-		AsLowercase: string(unicode.ToLower(codepoint)),
-		AsTitlecase: string(unicode.ToTitle(codepoint)),
+		Encodings:     getEncodings(codepoint),
+		Normalization: getNormalization(codepoint),
+		Bidi:          getBidi(codepoint),
+		Width:         getWidth(codepoint),
+		Confusables:   getConfusables(codepoint),
+		Emoji:         getCodepointEmoji(codepoint),
+		Segmentation:  getSegmentation(codepoint),
+		Neighbours:    getNeighbours(codepoint),
+	}
+}
 
-		HasDifferentCase: (unicode.IsUpper(codepoint) || unicode.IsLower(codepoint)) || unicode.IsTitle(codepoint),
+func serveCodepoint(writer http.ResponseWriter, request *http.Request, route string, timer time.Time) {
+	// an emoji sequence pasted into the address bar has a page of its own
+	if utf8.RuneCountInString(route) > 1 {
+		if _, ok := getEmojiIndex()[route]; ok {
+			http.Redirect(writer, request, "/emoji/"+emojiSequencePath(route), http.StatusMovedPermanently)
+			logNow(request, timer)
+			return
+		}
 	}
 
+	writer.Header().Add("Vary", "Accept")
+	if wantsJSON(request) {
+		serveCodepointJSON(writer, request, route, timer)
+		return
+	}
+
+	codepoint, err := parseCodepointRoute(route)
+	if err != nil {
+		serveError(writer, request, err, timer)
+		return
+	}
+
+	// every notation redirects to the one canonical URL
+	if canonical := fmt.Sprintf("%U", codepoint); route != canonical {
+		http.Redirect(writer, request, "/cp/"+canonical, http.StatusMovedPermanently)
+		logNow(request, timer)
+		return
+	}
+
+	writer = setHeaders(writer)
+
 	templateFiles := []string{
 		"./template/base.template.html",
 		"./template/rune.template.html",
 	}
 
-	serveFilesFromTemplate(writer, request, templateFiles, data, timer)
+	serveFilesFromTemplate(writer, request, templateFiles, getCodepointData(codepoint), timer)
+}
+
+func serveCodepointJSON(writer http.ResponseWriter, request *http.Request, route string, timer time.Time) {
+	codepoint, err := parseCodepointRoute(route)
+	if err != nil {
+		serveJSONError(writer, request, err, timer)
+		return
+	}
+
+	writer = setJSONHeaders(writer)
+	serveJSON(writer, request, getCodepointData(codepoint), timer)
 }
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// serverConfig is how the server is run. Every setting can be given in a
+// JSON config file, as an environment variable and as a flag, each
+// overriding the one before: "base_url" in the file, UNICODE_CLICK_BASE_URL
+// and -base-url.
+type serverConfig struct {
+	// HTTPAddress redirects to BaseURL, or serves the site with PlainHTTP.
+	HTTPAddress  string `json:"http_address"`
+	HTTPSAddress string `json:"https_address"`
+	// PlainHTTP serves the site on HTTPAddress without TLS, i.e. behind a
+	// proxy that terminates TLS.
+	PlainHTTP bool   `json:"plain_http"`
+	TLSCert   string `json:"tls_cert"`
+	TLSKey    string `json:"tls_key"`
+
+	// ACME requests certificates for ACMEHosts instead of reading TLSCert
+	// and TLSKey, answering HTTP-01 challenges on HTTPAddress.
+	ACME          bool   `json:"acme"`
+	ACMEHosts     string `json:"acme_hosts"`
+	ACMEEmail     string `json:"acme_email"`
+	ACMEDirectory string `json:"acme_directory"`
+	ACMECACert    string `json:"acme_ca_cert"`
+	ACMECache     string `json:"acme_cache"`
+
+	// BaseURL is where the site is reached, absolute URLs in pages and
+	// redirects start with it.
+	BaseURL string `json:"base_url"`
+	// LogDirectory gets a log file per run, empty logs to stdout only.
+	LogDirectory string `json:"log_directory"`
+
+	UCD    string `json:"ucd"`
+	Assets string `json:"assets"`
+	Dev    bool   `json:"dev"`
+}
+
+func defaultConfig() serverConfig {
+	return serverConfig{
+		HTTPAddress:  ":80",
+		HTTPSAddress: ":443",
+		TLSCert:      "./ssl/domain.cert.pem",
+		TLSKey:       "./ssl/private.key.pem",
+		ACMECache:    "./ssl/acme",
+		BaseURL:      "https://unicode.click",
+		LogDirectory: "./log",
+		UCD:          "./ucd",
+	}
+}
+
+var config = defaultConfig()
+
+// configSetting is one field of serverConfig, named as in the config file.
+type configSetting struct {
+	name    string
+	usage   string
+	text    *string
+	boolean *bool
+}
+
+func (setting configSetting) flagName() string {
+	return strings.ReplaceAll(setting.name, "_", "-")
+}
+
+func (setting configSetting) envName() string {
+	return "UNICODE_CLICK_" + strings.ToUpper(setting.name)
+}
+
+func (setting configSetting) set(value string) error {
+	if setting.boolean == nil {
+		*setting.text = value
+		return nil
+	}
+	boolean, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("%s: %w", setting.name, err)
+	}
+	*setting.boolean = boolean
+	return nil
+}
+
+func (c *serverConfig) settings() []configSetting {
+	return []configSetting{
+		{"http_address", "`address` to redirect to base_url from, or to serve on with plain_http", &c.HTTPAddress, nil},
+		{"https_address", "`address` to serve on with TLS", &c.HTTPSAddress, nil},
+		{"plain_http", "serve without TLS on http_address, i.e. behind a proxy terminating TLS", nil, &c.PlainHTTP},
+		{"tls_cert", "TLS certificate `file`", &c.TLSCert, nil},
+		{"tls_key", "TLS private key `file`", &c.TLSKey, nil},
+		{"acme", "request certificates through ACME instead of reading tls_cert and tls_key", nil, &c.ACME},
+		{"acme_hosts", "comma separated `hostnames` to request certificates for, the host of base_url by default", &c.ACMEHosts, nil},
+		{"acme_email", "contact `address` for the ACME account", &c.ACMEEmail, nil},
+		{"acme_directory", "ACME directory `URL`, Let's Encrypt by default", &c.ACMEDirectory, nil},
+		{"acme_ca_cert", "CA certificate `file` to trust for acme_directory, i.e. of a Pebble test server", &c.ACMECACert, nil},
+		{"acme_cache", "`directory` to keep ACME accounts and certificates in", &c.ACMECache, nil},
+		{"base_url", "canonical `URL` of the site, used for absolute links and redirects", &c.BaseURL, nil},
+		{"log_directory", "`directory` for log files, empty for stdout only", &c.LogDirectory, nil},
+		{"ucd", "`directory` with an unpacked Unicode Character Database", &c.UCD, nil},
+		{"assets", "`directory` with template/ and public/ to use instead of the embedded ones", &c.Assets, nil},
+		{"dev", "reload templates when they change, from the working directory unless assets is set", nil, &c.Dev},
+	}
+}
+
+// loadConfig reads the config file named by -config or UNICODE_CLICK_CONFIG,
+// then the environment, then the flags in args.
+func loadConfig(args []string) (serverConfig, error) {
+	loaded := defaultConfig()
+	flagged := defaultConfig()
+
+	flags := flag.NewFlagSet("unicode.click", flag.ContinueOnError)
+	configFile := flags.String("config", os.Getenv("UNICODE_CLICK_CONFIG"), "JSON config `file`")
+	for _, setting := range flagged.settings() {
+		if setting.boolean != nil {
+			flags.BoolVar(setting.boolean, setting.flagName(), *setting.boolean, setting.usage)
+		} else {
+			flags.StringVar(setting.text, setting.flagName(), *setting.text, setting.usage)
+		}
+	}
+	if err := flags.Parse(args); err != nil {
+		return loaded, err
+	}
+
+	if *configFile != "" {
+		file, err := os.Open(*configFile)
+		if err != nil {
+			return loaded, err
+		}
+		decoder := json.NewDecoder(file)
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&loaded)
+		file.Close()
+		if err != nil {
+			return loaded, fmt.Errorf("%s: %w", *configFile, err)
+		}
+	}
+
+	settings := loaded.settings()
+	for _, setting := range settings {
+		if value, ok := os.LookupEnv(setting.envName()); ok {
+			if err := setting.set(value); err != nil {
+				return loaded, fmt.Errorf("%s: %w", setting.envName(), err)
+			}
+		}
+	}
+
+	var err error
+	flags.Visit(func(f *flag.Flag) {
+		for _, setting := range settings {
+			if f.Name == setting.flagName() && err == nil {
+				err = setting.set(f.Value.String())
+			}
+		}
+	})
+	if err != nil {
+		return loaded, err
+	}
+
+	return loaded, loaded.validate()
+}
+
+func (c *serverConfig) validate() error {
+	base, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return fmt.Errorf("base_url: %w", err)
+	}
+	if base.Scheme == "" || base.Host == "" {
+		return fmt.Errorf("base_url: %q is not an absolute URL", c.BaseURL)
+	}
+	c.BaseURL = strings.TrimSuffix(c.BaseURL, "/")
+
+	if c.PlainHTTP && c.HTTPAddress == "" {
+		return fmt.Errorf("http_address: required with plain_http")
+	}
+	if !c.PlainHTTP && c.HTTPSAddress == "" {
+		return fmt.Errorf("https_address: required without plain_http")
+	}
+	if c.ACME && c.PlainHTTP {
+		return fmt.Errorf("acme: not possible with plain_http")
+	}
+	if c.ACME && c.HTTPAddress == "" {
+		return fmt.Errorf("http_address: required with acme, for HTTP-01 challenges")
+	}
+	return nil
+}
+
+// absoluteURL prefixes path with the base URL of the site.
+func absoluteURL(path string) string {
+	return config.BaseURL + path
+}
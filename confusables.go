@@ -0,0 +1,273 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// confusablesFile is not part of the UCD proper, it is published with the
+// security data (https://www.unicode.org/Public/security/latest/) and is
+// read from ucdDirectory too. Without it the built-in copy is used.
+const confusablesFile = "confusables.txt"
+
+// confusableTables maps every source character of confusables.txt to its
+// prototype, and every skeleton back to the characters that have it.
+type confusableTables struct {
+	prototypes map[rune]string
+	bySkeleton map[string][]rune
+}
+
+// Confusables lists the characters that look like a codepoint, they all
+// share its UTS #39 skeleton.
+type Confusables struct {
+	Skeleton    []CodepointLink `json:"skeleton"`
+	Confusables []CodepointLink `json:"confusables"`
+}
+
+// ConfusableDifference is a part of two compared strings that is not made
+// of the same codepoints. Positions count codepoints.
+type ConfusableDifference struct {
+	PositionA  int    `json:"position_a"`
+	PositionB  int    `json:"position_b"`
+	A          string `json:"a"`
+	B          string `json:"b"`
+	Confusable bool   `json:"confusable"`
+}
+
+// ConfusableComparison is the result of the /confusable tool.
+type ConfusableComparison struct {
+	A           string                 `json:"a"`
+	B           string                 `json:"b"`
+	SkeletonA   string                 `json:"skeleton_a"`
+	SkeletonB   string                 `json:"skeleton_b"`
+	Identical   bool                   `json:"identical"`
+	Confusable  bool                   `json:"confusable"`
+	Differences []ConfusableDifference `json:"differences"`
+}
+
+func (database *ucdDatabase) loadConfusables(path string) error {
+	prototypes := map[rune]string{}
+	_, err := parseUCDFile(path, func(fields []string) error {
+		if len(fields) < 2 {
+			return fmt.Errorf("expected at least 2 fields, got %d", len(fields))
+		}
+		source, _, err := parseUCDCodepoints(fields[0])
+		if err != nil {
+			return err
+		}
+		var prototype strings.Builder
+		for _, field := range strings.Fields(fields[1]) {
+			codepoint, _, err := parseUCDCodepoints(field)
+			if err != nil {
+				return err
+			}
+			prototype.WriteRune(codepoint)
+		}
+		prototypes[source] = prototype.String()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	database.confusables = newConfusableTables(prototypes)
+	return nil
+}
+
+// newConfusableTables indexes the skeleton of every source character and
+// of the prototypes that are single characters, since prototypes are
+// confusable with their sources too.
+func newConfusableTables(prototypes map[rune]string) *confusableTables {
+	tables := &confusableTables{prototypes: prototypes, bySkeleton: map[string][]rune{}}
+	seen := map[rune]bool{}
+	index := func(codepoint rune) {
+		if seen[codepoint] {
+			return
+		}
+		seen[codepoint] = true
+		skeleton := tables.skeleton(string(codepoint))
+		tables.bySkeleton[skeleton] = append(tables.bySkeleton[skeleton], codepoint)
+	}
+	for source, prototype := range tables.prototypes {
+		index(source)
+		if len([]rune(prototype)) == 1 {
+			index([]rune(prototype)[0])
+		}
+	}
+	for _, codepoints := range tables.bySkeleton {
+		sort.Slice(codepoints, func(i, j int) bool { return codepoints[i] < codepoints[j] })
+	}
+	return tables
+}
+
+// skeleton implements the skeleton(X) transform of UTS #39: decompose,
+// replace every character by its prototype and decompose again.
+func (tables *confusableTables) skeleton(text string) string {
+	var mapped strings.Builder
+	for _, codepoint := range norm.NFD.String(text) {
+		if prototype, ok := tables.prototypes[codepoint]; ok {
+			mapped.WriteString(prototype)
+			continue
+		}
+		mapped.WriteRune(codepoint)
+	}
+	return norm.NFD.String(mapped.String())
+}
+
+var (
+	builtinConfusableTables     *confusableTables
+	builtinConfusableTablesOnce sync.Once
+)
+
+func getConfusableTables() *confusableTables {
+	if ucd != nil && ucd.confusables != nil {
+		return ucd.confusables
+	}
+	builtinConfusableTablesOnce.Do(func() {
+		builtinConfusableTables = newConfusableTables(builtinConfusables)
+	})
+	return builtinConfusableTables
+}
+
+// getConfusables returns the characters that share the skeleton of
+// codepoint.
+func getConfusables(codepoint rune) *Confusables {
+	tables := getConfusableTables()
+
+	skeleton := tables.skeleton(string(codepoint))
+	confusables := &Confusables{
+		Skeleton:    getCodepointLinks(skeleton),
+		Confusables: []CodepointLink{},
+	}
+	for _, confusable := range tables.bySkeleton[skeleton] {
+		if confusable != codepoint {
+			confusables.Confusables = append(confusables.Confusables, CodepointLink{fmt.Sprintf("%U", confusable), string(confusable)})
+		}
+	}
+	return confusables
+}
+
+// confusableDifferences lines up a and b by the skeletons of their
+// codepoints and returns the parts made of different codepoints. Codepoints
+// are taken from either side until the skeletons match, or until neither is
+// a prefix of the other, which makes a part that is not confusable.
+func (tables *confusableTables) confusableDifferences(a, b string) []ConfusableDifference {
+	runesA, runesB := []rune(a), []rune(b)
+	differences := []ConfusableDifference{}
+
+	i, j := 0, 0
+	for i < len(runesA) || j < len(runesB) {
+		startA, startB := i, j
+		var skeletonA, skeletonB string
+		takeA := func() {
+			skeletonA += tables.skeleton(string(runesA[i]))
+			i++
+		}
+		takeB := func() {
+			skeletonB += tables.skeleton(string(runesB[j]))
+			j++
+		}
+
+		if i < len(runesA) {
+			takeA()
+		}
+		if j < len(runesB) {
+			takeB()
+		}
+		for skeletonA != skeletonB {
+			if i < len(runesA) && strings.HasPrefix(skeletonB, skeletonA) {
+				takeA()
+				continue
+			}
+			if j < len(runesB) && strings.HasPrefix(skeletonA, skeletonB) {
+				takeB()
+				continue
+			}
+			break
+		}
+
+		partA, partB := string(runesA[startA:i]), string(runesB[startB:j])
+		if partA != partB {
+			differences = append(differences, ConfusableDifference{
+				PositionA:  startA,
+				PositionB:  startB,
+				A:          partA,
+				B:          partB,
+				Confusable: skeletonA == skeletonB,
+			})
+		}
+	}
+
+	return differences
+}
+
+func getConfusableComparison(request *http.Request) (ConfusableComparison, error) {
+	tables := getConfusableTables()
+
+	query := request.URL.Query()
+	a, b := query.Get("a"), query.Get("b")
+	for _, input := range []string{a, b} {
+		if len(input) > maxInspectLength {
+			return ConfusableComparison{}, &inputError{errInputTooLong, fmt.Sprintf("%d bytes", len(input)), nil}
+		}
+	}
+
+	comparison := ConfusableComparison{
+		A:           a,
+		B:           b,
+		SkeletonA:   tables.skeleton(a),
+		SkeletonB:   tables.skeleton(b),
+		Identical:   a == b,
+		Differences: tables.confusableDifferences(a, b),
+	}
+	comparison.Confusable = comparison.SkeletonA == comparison.SkeletonB
+	return comparison, nil
+}
+
+func serveConfusableJSON(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	comparison, err := getConfusableComparison(request)
+	if err != nil {
+		serveJSONError(writer, request, err, timer)
+		return
+	}
+
+	writer = setJSONHeaders(writer)
+	serveJSON(writer, request, comparison, timer)
+}
+
+func serveConfusable(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	writer.Header().Add("Vary", "Accept")
+	if wantsJSON(request) {
+		serveConfusableJSON(writer, request, timer)
+		return
+	}
+
+	comparison, err := getConfusableComparison(request)
+	if err != nil {
+		serveError(writer, request, err, timer)
+		return
+	}
+
+	writer = setHeaders(writer)
+
+	data := struct {
+		ConfusableComparison
+		UnicodeVersion string
+	}{
+		ConfusableComparison: comparison,
+		UnicodeVersion:       unicodeVersion(),
+	}
+
+	templateFiles := []string{
+		"./template/base.template.html",
+		"./template/confusable.template.html",
+	}
+
+	serveFilesFromTemplate(writer, request, templateFiles, data, timer)
+}
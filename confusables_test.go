@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// useUCD makes database the loaded UCD until the end of the test.
+func useUCD(t *testing.T, database *ucdDatabase) {
+	previous := ucd
+	ucd = database
+	t.Cleanup(func() { ucd = previous })
+}
+
+func compareConfusable(t *testing.T, a, b string) ConfusableComparison {
+	t.Helper()
+	var comparison ConfusableComparison
+	recorder := serveTestRequest("/api/v1/confusable?a=" + a + "&b=" + b)
+	if err := json.NewDecoder(recorder.Body).Decode(&comparison); err != nil {
+		t.Fatalf("got status %d, %v", recorder.Code, err)
+	}
+	return comparison
+}
+
+func TestServeConfusableBuiltin(t *testing.T) {
+	if recorder := serveTestRequest("/confusable"); recorder.Code != 200 {
+		t.Errorf("/confusable: got status %d, want 200", recorder.Code)
+	}
+	if body := serveTestRequest("/").Body.String(); !strings.Contains(body, `href="/confusable"`) {
+		t.Error("index does not link /confusable")
+	}
+
+	// CYRILLIC SMALL LETTER A and DIGIT ONE look like Latin letters
+	if comparison := compareConfusable(t, "%D0%B0pp1e", "apple"); !comparison.Confusable {
+		t.Errorf("%q and %q are not confusable, skeletons %q and %q", comparison.A, comparison.B, comparison.SkeletonA, comparison.SkeletonB)
+	}
+	if comparison := compareConfusable(t, "apple", "orange"); comparison.Confusable {
+		t.Errorf("%q and %q are confusable", comparison.A, comparison.B)
+	}
+
+	found := false
+	for _, confusable := range getConfusables(0x61).Confusables {
+		found = found || confusable.Codepoint == "U+0430"
+	}
+	if !found {
+		t.Errorf("U+0061 is not confusable with U+0430: %+v", getConfusables(0x61))
+	}
+}
+
+func TestServeConfusable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), confusablesFile)
+	if err := os.WriteFile(path, []byte("0430 ;\t0062 ;\tMA\t# ( а → b )\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	database := &ucdDatabase{}
+	if err := database.loadConfusables(path); err != nil {
+		t.Fatal(err)
+	}
+	useUCD(t, database)
+
+	// the loaded file replaces the built-in one
+	if comparison := compareConfusable(t, "%D0%B0", "b"); !comparison.Confusable {
+		t.Errorf("%q and %q are not confusable, skeletons %q and %q", comparison.A, comparison.B, comparison.SkeletonA, comparison.SkeletonB)
+	}
+	if comparison := compareConfusable(t, "%D0%B0", "a"); comparison.Confusable {
+		t.Errorf("%q and %q are confusable", comparison.A, comparison.B)
+	}
+}
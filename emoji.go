@@ -0,0 +1,586 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The emoji data lives in the emoji directory of the UCD, except for
+// emoji-test.txt and the sequence files which are published with the emoji
+// releases (https://www.unicode.org/Public/emoji/latest/) and are expected
+// next to emoji-data.txt.
+const (
+	emojiDataFile         = "emoji/emoji-data.txt"
+	emojiSequencesFile    = "emoji/emoji-sequences.txt"
+	emojiZWJSequencesFile = "emoji/emoji-zwj-sequences.txt"
+	emojiTestFile         = "emoji/emoji-test.txt"
+)
+
+// maxEmojiSequenceLength caps the number of codepoints of /emoji pages, the
+// longest RGI sequences have ten.
+const maxEmojiSequenceLength = 32
+
+// emojiPropertyNames are the properties of emoji-data.txt, in the order
+// they are shown.
+var emojiPropertyNames = []string{
+	"Emoji",
+	"Emoji_Presentation",
+	"Emoji_Modifier",
+	"Emoji_Modifier_Base",
+	"Emoji_Component",
+	"Extended_Pictographic",
+}
+
+// emojiGroup is a "# group:" section of emoji-test.txt, the CLDR grouping
+// used by emoji keyboards.
+type emojiGroup struct {
+	name      string
+	subgroups []emojiSubgroup
+}
+
+type emojiSubgroup struct {
+	name  string
+	emoji []emojiEntry
+}
+
+// emojiEntry is one line of emoji-test.txt. status is fully-qualified,
+// minimally-qualified, unqualified or component.
+type emojiEntry struct {
+	sequence string
+	status   string
+	version  string
+	name     string
+}
+
+// emojiSequenceType is one line of emoji-sequences.txt or
+// emoji-zwj-sequences.txt.
+type emojiSequenceType struct {
+	kind string
+	name string
+}
+
+// emojiPosition locates an entry of emoji-test.txt.
+type emojiPosition struct {
+	group, subgroup int
+	entry           emojiEntry
+}
+
+// EmojiLink is an emoji in a form that can be linked to its page.
+type EmojiLink struct {
+	Sequence string `json:"sequence"`
+	Text     string `json:"text"`
+	Name     string `json:"name"`
+}
+
+type EmojiSubgroup struct {
+	Name  string      `json:"name"`
+	Emoji []EmojiLink `json:"emoji"`
+}
+
+type EmojiGroup struct {
+	Name      string          `json:"name"`
+	Slug      string          `json:"-"`
+	Subgroups []EmojiSubgroup `json:"subgroups"`
+}
+
+// EmojiBrowser is every fully-qualified emoji, the document behind /emoji.
+type EmojiBrowser struct {
+	EmojiVersion string       `json:"emoji_version"`
+	Groups       []EmojiGroup `json:"groups"`
+}
+
+// EmojiComponent is one codepoint of an emoji sequence and the part it
+// plays in it.
+type EmojiComponent struct {
+	Codepoint string `json:"codepoint"`
+	Character string `json:"character"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+}
+
+// EmojiSequence is what /emoji/<sequence> shows. Status, Group and Subgroup
+// are empty for sequences that emoji-test.txt does not list.
+type EmojiSequence struct {
+	Sequence     string           `json:"sequence"`
+	Text         string           `json:"text"`
+	Name         string           `json:"name"`
+	Type         string           `json:"type"`
+	Status       string           `json:"status"`
+	EmojiVersion string           `json:"emoji_version"`
+	Group        string           `json:"group"`
+	Subgroup     string           `json:"subgroup"`
+	Components   []EmojiComponent `json:"components"`
+	Variants     []EmojiLink      `json:"variants"`
+}
+
+// CodepointEmoji is the emoji data on a codepoint page, Sequence is the
+// fully-qualified emoji the codepoint is the base of.
+type CodepointEmoji struct {
+	Properties []string `json:"properties"`
+	Sequence   string   `json:"sequence,omitempty"`
+}
+
+func (database *ucdDatabase) loadEmojiData(path string) (err error) {
+	database.emojiProperties, _, err = loadPropertyFile(path)
+	return err
+}
+
+// loadEmojiSequences reads emoji-sequences.txt or emoji-zwj-sequences.txt.
+// Single codepoints and ranges are left out, they are covered by
+// emoji-data.txt.
+func (database *ucdDatabase) loadEmojiSequences(path string) error {
+	if database.emojiSequences == nil {
+		database.emojiSequences = map[string]emojiSequenceType{}
+	}
+	_, err := parseUCDFile(path, func(fields []string) error {
+		if len(fields) < 3 {
+			return fmt.Errorf("expected 3 fields, got %d", len(fields))
+		}
+		if !strings.Contains(fields[0], " ") {
+			return nil
+		}
+		sequence, err := parseEmojiCodepoints(strings.Fields(fields[0]))
+		if err != nil {
+			return err
+		}
+		database.emojiSequences[sequence] = emojiSequenceType{fields[1], fields[2]}
+		return nil
+	})
+	return err
+}
+
+var (
+	emojiVersionPattern = regexp.MustCompile(`^#\s*Version:\s*(\S+)`)
+	emojiTestPattern    = regexp.MustCompile(`^([0-9A-F ]+);\s*([a-z-]+)\s*#\s*\S+\s+(E\d+\.\d+)\s+(.*)$`)
+)
+
+// loadEmojiTest reads emoji-test.txt, which keeps its groups in comments and
+// so cannot go through parseUCDFile.
+func (database *ucdDatabase) loadEmojiTest(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var groups []emojiGroup
+	scanner := bufio.NewScanner(f)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		switch {
+		case emojiVersionPattern.MatchString(line) && database.emojiVersion == "":
+			database.emojiVersion = emojiVersionPattern.FindStringSubmatch(line)[1]
+		case strings.HasPrefix(line, "# group: "):
+			groups = append(groups, emojiGroup{name: strings.TrimPrefix(line, "# group: ")})
+		case strings.HasPrefix(line, "# subgroup: "):
+			if len(groups) == 0 {
+				return fmt.Errorf("line %d: subgroup outside of a group", lineNumber)
+			}
+			group := &groups[len(groups)-1]
+			group.subgroups = append(group.subgroups, emojiSubgroup{name: strings.TrimPrefix(line, "# subgroup: ")})
+		default:
+			match := emojiTestPattern.FindStringSubmatch(line)
+			if match == nil {
+				continue
+			}
+			if len(groups) == 0 || len(groups[len(groups)-1].subgroups) == 0 {
+				return fmt.Errorf("line %d: emoji outside of a subgroup", lineNumber)
+			}
+			sequence, err := parseEmojiCodepoints(strings.Fields(match[1]))
+			if err != nil {
+				return fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+			group := &groups[len(groups)-1]
+			subgroup := &group.subgroups[len(group.subgroups)-1]
+			subgroup.emoji = append(subgroup.emoji, emojiEntry{sequence, match[2], match[3], match[4]})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	database.emojiGroups = groups
+	return nil
+}
+
+func parseEmojiCodepoints(fields []string) (string, error) {
+	var sequence strings.Builder
+	for _, field := range fields {
+		codepoint, _, err := parseUCDCodepoints(field)
+		if err != nil {
+			return "", err
+		}
+		sequence.WriteRune(codepoint)
+	}
+	return sequence.String(), nil
+}
+
+var (
+	builtinEmojiTables     map[string]*unicode.RangeTable
+	builtinEmojiTablesOnce sync.Once
+)
+
+func emojiPropertyTables() map[string]*unicode.RangeTable {
+	if ucd != nil && ucd.emojiProperties != nil {
+		return ucd.emojiProperties
+	}
+	builtinEmojiTablesOnce.Do(func() {
+		builtinEmojiTables = rangeTablesByValue(builtinEmojiProperties)
+	})
+	return builtinEmojiTables
+}
+
+func emojiSequenceTypes() map[string]emojiSequenceType {
+	if ucd != nil && ucd.emojiSequences != nil {
+		return ucd.emojiSequences
+	}
+	return builtinEmojiSequences
+}
+
+func emojiGroups() (groups []emojiGroup, version string) {
+	if ucd != nil && ucd.emojiGroups != nil {
+		return ucd.emojiGroups, ucd.emojiVersion
+	}
+	return builtinEmojiGroups, builtinEmojiTestVersion
+}
+
+var (
+	emojiIndex     map[string]emojiPosition
+	emojiIndexOnce sync.Once
+)
+
+// getEmojiIndex maps every sequence of emoji-test.txt to where it is listed.
+func getEmojiIndex() map[string]emojiPosition {
+	emojiIndexOnce.Do(func() {
+		groups, _ := emojiGroups()
+		emojiIndex = map[string]emojiPosition{}
+		for g, group := range groups {
+			for s, subgroup := range group.subgroups {
+				for _, entry := range subgroup.emoji {
+					emojiIndex[entry.sequence] = emojiPosition{g, s, entry}
+				}
+			}
+		}
+	})
+	return emojiIndex
+}
+
+// emojiSequencePath writes a sequence the way /emoji/ pages are addressed:
+// hexadecimal codepoints joined by dashes.
+func emojiSequencePath(sequence string) string {
+	var hex []string
+	for _, codepoint := range sequence {
+		hex = append(hex, fmt.Sprintf("%04X", codepoint))
+	}
+	return strings.Join(hex, "-")
+}
+
+func getEmojiLink(entry emojiEntry) EmojiLink {
+	return EmojiLink{emojiSequencePath(entry.sequence), entry.sequence, entry.name}
+}
+
+// parseEmojiHex reads dashed hexadecimal codepoints.
+func parseEmojiHex(route string) (string, bool) {
+	var sequence strings.Builder
+	for _, part := range strings.Split(route, "-") {
+		codepoint, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(part), "U+"), 16, 32)
+		if err != nil || codepoint > unicode.MaxRune {
+			return "", false
+		}
+		sequence.WriteRune(rune(codepoint))
+	}
+	return sequence.String(), true
+}
+
+// parseEmojiRoute accepts a sequence both as dashed hexadecimal
+// (1F468-200D-1F469) and as the emoji itself.
+func parseEmojiRoute(route string) (string, error) {
+	sequence := route
+	if hex, ok := parseEmojiHex(route); ok {
+		sequence = hex
+	}
+
+	if !utf8.ValidString(sequence) || utf8.RuneCountInString(sequence) > maxEmojiSequenceLength {
+		return "", &inputError{errMalformedInput, route, nil}
+	}
+	if _, ok := getEmojiIndex()[sequence]; ok {
+		return sequence, nil
+	}
+	if _, ok := emojiSequenceTypes()[sequence]; ok {
+		return sequence, nil
+	}
+	if isPossibleEmoji(sequence) {
+		return sequence, nil
+	}
+	return "", &inputError{errNotEmoji, route, []suggestion{
+		{"all emoji", "/emoji"},
+		{"search by name", "/search?" + url.Values{"q": {route}}.Encode()},
+	}}
+}
+
+// isPossibleEmoji reports whether sequence parses as a possible emoji of the
+// UTS #51 grammar, whether or not it is recommended:
+//
+//	possible_emoji := emoji_zwj_element ( \x{200D} emoji_zwj_element )*
+//	emoji_zwj_element := \p{RI} \p{RI} | \p{Emoji} emoji_modification?
+//	emoji_modification := \p{EMod} | \x{FE0F} \x{20E3}? | tag_modifier
+//	tag_modifier := [\x{E0020}-\x{E007E}]+ \x{E007F}
+func isPossibleEmoji(sequence string) bool {
+	tables := emojiPropertyTables()
+	isRegionalIndicator := func(codepoint rune) bool {
+		return codepoint >= 0x1F1E6 && codepoint <= 0x1F1FF
+	}
+	isTag := func(codepoint rune) bool {
+		return codepoint >= 0xE0020 && codepoint <= 0xE007E
+	}
+
+	for _, element := range strings.Split(sequence, "\u200D") {
+		runes := []rune(element)
+		switch {
+		case len(runes) == 0:
+			return false
+		case len(runes) == 2 && isRegionalIndicator(runes[0]) && isRegionalIndicator(runes[1]):
+			continue
+		case !unicode.Is(tables["Emoji"], runes[0]):
+			return false
+		}
+
+		modification := runes[1:]
+		switch {
+		case len(modification) == 0:
+		case len(modification) == 1 && unicode.Is(tables["Emoji_Modifier"], modification[0]):
+		case modification[0] == 0xFE0F:
+			if len(modification) > 2 || len(modification) == 2 && modification[1] != 0x20E3 {
+				return false
+			}
+		default:
+			if len(modification) < 2 || modification[len(modification)-1] != 0xE007F {
+				return false
+			}
+			for _, codepoint := range modification[:len(modification)-1] {
+				if !isTag(codepoint) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// emojiComponentRole names the part a codepoint plays in a sequence.
+func emojiComponentRole(codepoint rune) string {
+	switch {
+	case codepoint == 0x200D:
+		return "zero width joiner"
+	case codepoint == 0xFE0F:
+		return "emoji presentation selector"
+	case codepoint == 0xFE0E:
+		return "text presentation selector"
+	case codepoint == 0x20E3:
+		return "keycap"
+	case codepoint >= 0x1F1E6 && codepoint <= 0x1F1FF:
+		return "regional indicator"
+	case codepoint >= 0xE0020 && codepoint <= 0xE007E:
+		return "tag"
+	case codepoint == 0xE007F:
+		return "cancel tag"
+	case unicode.Is(emojiPropertyTables()["Emoji_Modifier"], codepoint):
+		return "skin tone modifier"
+	case codepoint >= 0x1F9B0 && codepoint <= 0x1F9B3:
+		return "hair style component"
+	}
+	return "base"
+}
+
+// emojiSequenceKind returns the type of a sequence from the sequence files,
+// or the UTS #51 definition it meets when they do not list it.
+func emojiSequenceKind(sequence string) string {
+	if sequenceType, ok := emojiSequenceTypes()[sequence]; ok {
+		return sequenceType.kind
+	}
+
+	runes := []rune(sequence)
+	switch {
+	case strings.ContainsRune(sequence, 0x200D):
+		return "emoji zwj sequence"
+	case len(runes) == 2 && emojiComponentRole(runes[0]) == "regional indicator":
+		return "emoji flag sequence"
+	case strings.ContainsRune(sequence, 0xE007F):
+		return "emoji tag sequence"
+	case strings.HasSuffix(sequence, "\u20E3"):
+		return "emoji keycap sequence"
+	case len(runes) == 2 && emojiComponentRole(runes[1]) == "skin tone modifier":
+		return "emoji modifier sequence"
+	case len(runes) == 2 && runes[1] == 0xFE0F:
+		return "emoji presentation sequence"
+	case len(runes) == 1 && unicode.Is(emojiPropertyTables()["Emoji_Presentation"], runes[0]) && emojiComponentRole(runes[0]) != "regional indicator":
+		// single codepoints are left out of the sequence files
+		return "Basic_Emoji"
+	}
+	return "emoji character"
+}
+
+func getEmojiSequence(sequence string) EmojiSequence {
+	data := EmojiSequence{
+		Sequence:   emojiSequencePath(sequence),
+		Text:       sequence,
+		Type:       emojiSequenceKind(sequence),
+		Components: []EmojiComponent{},
+		Variants:   []EmojiLink{},
+	}
+
+	groups, _ := emojiGroups()
+	if position, ok := getEmojiIndex()[sequence]; ok {
+		data.Name = position.entry.name
+		data.Status = position.entry.status
+		data.EmojiVersion = position.entry.version
+		data.Group = groups[position.group].name
+		data.Subgroup = groups[position.group].subgroups[position.subgroup].name
+
+		// the same emoji with or without presentation selectors
+		bare := strings.ReplaceAll(sequence, "\uFE0F", "")
+		for _, entry := range groups[position.group].subgroups[position.subgroup].emoji {
+			if entry.sequence != sequence && entry.name == position.entry.name && strings.ReplaceAll(entry.sequence, "\uFE0F", "") == bare {
+				data.Variants = append(data.Variants, getEmojiLink(entry))
+			}
+		}
+	} else {
+		data.Name = emojiSequenceTypes()[sequence].name
+	}
+
+	for _, codepoint := range sequence {
+		data.Components = append(data.Components, EmojiComponent{
+			Codepoint: fmt.Sprintf("%U", codepoint),
+			Character: string(codepoint),
+			Name:      runeName(codepoint),
+			Role:      emojiComponentRole(codepoint),
+		})
+	}
+
+	return data
+}
+
+func getEmojiBrowser() EmojiBrowser {
+	groups, version := emojiGroups()
+	browser := EmojiBrowser{EmojiVersion: version, Groups: []EmojiGroup{}}
+	for _, group := range groups {
+		browserGroup := EmojiGroup{Name: group.name, Slug: blockSlug(group.name), Subgroups: []EmojiSubgroup{}}
+		for _, subgroup := range group.subgroups {
+			browserSubgroup := EmojiSubgroup{Name: subgroup.name, Emoji: []EmojiLink{}}
+			for _, entry := range subgroup.emoji {
+				if entry.status == "fully-qualified" || entry.status == "component" {
+					browserSubgroup.Emoji = append(browserSubgroup.Emoji, getEmojiLink(entry))
+				}
+			}
+			browserGroup.Subgroups = append(browserGroup.Subgroups, browserSubgroup)
+		}
+		browser.Groups = append(browser.Groups, browserGroup)
+	}
+	return browser
+}
+
+// getCodepointEmoji returns nil for codepoints without emoji properties.
+func getCodepointEmoji(codepoint rune) *CodepointEmoji {
+	tables := emojiPropertyTables()
+	data := &CodepointEmoji{}
+	for _, property := range emojiPropertyNames {
+		if table, ok := tables[property]; ok && unicode.Is(table, codepoint) {
+			data.Properties = append(data.Properties, property)
+		}
+	}
+	if data.Properties == nil {
+		return nil
+	}
+
+	index := getEmojiIndex()
+	for _, sequence := range []string{string(codepoint), string(codepoint) + "\uFE0F"} {
+		if position, ok := index[sequence]; ok && position.entry.status == "fully-qualified" {
+			data.Sequence = emojiSequencePath(sequence)
+		}
+	}
+	return data
+}
+
+func serveEmojiBrowserJSON(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	writer = setJSONHeaders(writer)
+	serveJSON(writer, request, getEmojiBrowser(), timer)
+}
+
+func serveEmojiBrowser(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	writer.Header().Add("Vary", "Accept")
+	if wantsJSON(request) {
+		serveEmojiBrowserJSON(writer, request, timer)
+		return
+	}
+
+	writer = setHeaders(writer)
+
+	data := struct {
+		EmojiBrowser
+		UnicodeVersion string
+	}{
+		EmojiBrowser:   getEmojiBrowser(),
+		UnicodeVersion: unicodeVersion(),
+	}
+
+	templateFiles := []string{
+		"./template/base.template.html",
+		"./template/emoji.template.html",
+	}
+
+	serveFilesFromTemplate(writer, request, templateFiles, data, timer)
+}
+
+func serveEmojiSequenceJSON(writer http.ResponseWriter, request *http.Request, route string, timer time.Time) {
+	sequence, err := parseEmojiRoute(route)
+	if err != nil {
+		serveJSONError(writer, request, err, timer)
+		return
+	}
+
+	writer = setJSONHeaders(writer)
+	serveJSON(writer, request, getEmojiSequence(sequence), timer)
+}
+
+func serveEmojiSequence(writer http.ResponseWriter, request *http.Request, route string, timer time.Time) {
+	writer.Header().Add("Vary", "Accept")
+	if wantsJSON(request) {
+		serveEmojiSequenceJSON(writer, request, route, timer)
+		return
+	}
+
+	sequence, err := parseEmojiRoute(route)
+	if err != nil {
+		serveError(writer, request, err, timer)
+		return
+	}
+
+	writer = setHeaders(writer)
+
+	data := struct {
+		EmojiSequence
+		UnicodeVersion string
+	}{
+		EmojiSequence:  getEmojiSequence(sequence),
+		UnicodeVersion: unicodeVersion(),
+	}
+
+	templateFiles := []string{
+		"./template/base.template.html",
+		"./template/sequence.template.html",
+	}
+
+	serveFilesFromTemplate(writer, request, templateFiles, data, timer)
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseEmojiRoute(t *testing.T) {
+	for _, route := range []string{"abc1z", "hello#world", "1a", "a⃣", "\U0001F600‍", "\U0001F600x", "\U0001F3F4\U000E0067\U000E0062"} {
+		if sequence, err := parseEmojiRoute(route); err == nil {
+			t.Errorf("parseEmojiRoute(%q) = %+q, want an error", route, sequence)
+		}
+	}
+
+	for route, want := range map[string]string{
+		"1F468-200D-1F469-200D-1F467": "RGI_Emoji_ZWJ_Sequence",
+		"1F1EB-1F1F7":                 "RGI_Emoji_Flag_Sequence",
+		"0031-FE0F-20E3":              "Emoji_Keycap_Sequence",
+		"1F600":                       "Basic_Emoji",
+		"0031":                        "emoji character",
+		"1F600-1F3FB":                 "emoji modifier sequence",
+		"1F1E6-1F1E6":                 "emoji flag sequence",
+		"1F600-200D-1F600":            "emoji zwj sequence",
+	} {
+		sequence, err := parseEmojiRoute(route)
+		if err != nil {
+			t.Errorf("parseEmojiRoute(%q): %v", route, err)
+			continue
+		}
+		if kind := emojiSequenceKind(sequence); kind != want {
+			t.Errorf("%s: got %s, want %s", route, kind, want)
+		}
+	}
+}
+
+func TestServeEmojiSequence(t *testing.T) {
+	for target, want := range map[string]int{
+		"/emoji/abc1z":         404,
+		"/emoji/hello%23world": 404,
+		"/emoji/1F44D-1F3FD":   200,
+		"/emoji/%F0%9F%91%8D":  200,
+		"/api/v1/emoji/abc1z":  404,
+	} {
+		if recorder := serveTestRequest(target); recorder.Code != want {
+			t.Errorf("%s: got status %d, want %d", target, recorder.Code, want)
+		}
+	}
+
+	// every listed sequence has a page, its name comes from emoji-test.txt
+	// when it is listed there too
+	for sequence, sequenceType := range emojiSequenceTypes() {
+		var data EmojiSequence
+		recorder := serveTestRequest("/api/v1/emoji/" + emojiSequencePath(sequence))
+		if err := json.NewDecoder(recorder.Body).Decode(&data); err != nil {
+			t.Errorf("%s: got status %d, %v", emojiSequencePath(sequence), recorder.Code, err)
+			continue
+		}
+		if data.Type != sequenceType.kind || data.Name == "" {
+			t.Errorf("%s: got %s %q, want %s", emojiSequencePath(sequence), data.Type, data.Name, sequenceType.kind)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+//go:generate go run gen_entities.go -entities ./entities.json
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+// Encodings is a codepoint written out as bytes and as the escape syntax of
+// common languages and formats.
+type Encodings struct {
+	UTF8  string `json:"utf8"`
+	UTF16 string `json:"utf16"`
+	UTF32 string `json:"utf32"`
+
+	Go         string `json:"go"`
+	JavaScript string `json:"javascript"`
+	Python     string `json:"python"`
+	Rust       string `json:"rust"`
+	C          string `json:"c"`
+	JSON       string `json:"json"`
+
+	HTMLDecimal string `json:"html_decimal"`
+	HTMLHex     string `json:"html_hex"`
+	HTMLNamed   string `json:"html_named,omitempty"`
+	CSS         string `json:"css"`
+	URL         string `json:"url"`
+}
+
+// escapeUnicode writes codepoint as \x for ASCII, \u for the rest of the BMP
+// and \U for supplementary planes, which is what Go and Python accept, and C
+// apart from the C1 controls, see escapeC.
+func escapeUnicode(codepoint rune) string {
+	switch {
+	case codepoint < 0x80:
+		return fmt.Sprintf(`\x%02X`, codepoint)
+	case codepoint <= 0xFFFF:
+		return fmt.Sprintf(`\u%04X`, codepoint)
+	}
+	return fmt.Sprintf(`\U%08X`, codepoint)
+}
+
+// escapeC is escapeUnicode for C, where universal character names below
+// U+00A0 are invalid (C11 6.4.3), so the C1 controls are written as their
+// UTF-8 bytes instead.
+func escapeC(codepoint rune) string {
+	if codepoint < 0x80 || codepoint > 0x9F {
+		return escapeUnicode(codepoint)
+	}
+	var escaped strings.Builder
+	for _, b := range []byte(string(codepoint)) {
+		fmt.Fprintf(&escaped, `\x%02X`, b)
+	}
+	return escaped.String()
+}
+
+// escapeUTF16 writes codepoint as one or two \u escapes, the only form JSON
+// knows.
+func escapeUTF16(codepoint rune) string {
+	var escaped strings.Builder
+	for _, unit := range utf16.Encode([]rune{codepoint}) {
+		fmt.Fprintf(&escaped, `\u%04X`, unit)
+	}
+	return escaped.String()
+}
+
+// percentEncode escapes every byte, unlike net/url which leaves unreserved
+// characters alone.
+func percentEncode(text string) string {
+	var escaped strings.Builder
+	for i := 0; i < len(text); i++ {
+		fmt.Fprintf(&escaped, "%%%02X", text[i])
+	}
+	return escaped.String()
+}
+
+func getEncodings(codepoint rune) Encodings {
+	literal := string(codepoint)
+
+	encodings := Encodings{
+		UTF8:  formatUTF8(literal),
+		UTF16: formatUTF16(utf16.Encode([]rune{codepoint})),
+		UTF32: fmt.Sprintf("%08X", codepoint),
+
+		Go:     escapeUnicode(codepoint),
+		Python: escapeUnicode(codepoint),
+		C:      escapeC(codepoint),
+		Rust:   fmt.Sprintf(`\u{%X}`, codepoint),
+		JSON:   escapeUTF16(codepoint),
+
+		HTMLDecimal: fmt.Sprintf("&#%d;", codepoint),
+		HTMLHex:     fmt.Sprintf("&#x%X;", codepoint),
+		CSS:         fmt.Sprintf(`\%X`, codepoint),
+		URL:         percentEncode(literal),
+	}
+
+	// supplementary planes use the ES2015 \u{...} form, which is easier to
+	// read than a surrogate pair
+	encodings.JavaScript = escapeUTF16(codepoint)
+	if codepoint > 0xFFFF {
+		encodings.JavaScript = fmt.Sprintf(`\u{%X}`, codepoint)
+	} else if codepoint < 0x80 {
+		encodings.JavaScript = escapeUnicode(codepoint)
+	}
+
+	if name, ok := htmlEntityNames[codepoint]; ok {
+		encodings.HTMLNamed = "&" + name + ";"
+	}
+
+	return encodings
+}
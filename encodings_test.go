@@ -0,0 +1,33 @@
+package main
+
+import "testing"
+
+func TestEscapeC(t *testing.T) {
+	for codepoint, want := range map[rune]string{
+		'A':     `\x41`,
+		0x80:    `\xC2\x80`,
+		0x9F:    `\xC2\x9F`,
+		0xA0:    `\u00A0`,
+		0x1F600: `\U0001F600`,
+	} {
+		if got := escapeC(codepoint); got != want {
+			t.Errorf("%U: got %s, want %s", codepoint, got, want)
+		}
+	}
+}
+
+func TestHTMLNamedEntity(t *testing.T) {
+	for codepoint, want := range map[rune]string{
+		'&':    "&amp;",
+		0xA0:   "&nbsp;",
+		0xC5:   "&Aring;", // not &angst;, defined for U+212B
+		0x3A9:  "&Omega;", // not &ohm;, defined for U+2126
+		0x2026: "&hellip;",
+		0x226A: "&ll;",
+		0x41:   "",
+	} {
+		if got := getEncodings(codepoint).HTMLNamed; got != want {
+			t.Errorf("%U: got %q, want %q", codepoint, got, want)
+		}
+	}
+}
@@ -0,0 +1,1454 @@
+// Code generated by gen_entities.go; DO NOT EDIT.
+
+package main
+
+// htmlEntityNames maps codepoints to their preferred HTML named character
+// reference, without the leading & and trailing ;.
+var htmlEntityNames = map[rune]string{
+	0x0009:  "Tab",
+	0x000A:  "NewLine",
+	0x0021:  "excl",
+	0x0022:  "quot",
+	0x0023:  "num",
+	0x0024:  "dollar",
+	0x0025:  "percnt",
+	0x0026:  "amp",
+	0x0027:  "apos",
+	0x0028:  "lpar",
+	0x0029:  "rpar",
+	0x002A:  "ast",
+	0x002B:  "plus",
+	0x002C:  "comma",
+	0x002E:  "period",
+	0x002F:  "sol",
+	0x003A:  "colon",
+	0x003B:  "semi",
+	0x003C:  "lt",
+	0x003D:  "equals",
+	0x003E:  "gt",
+	0x003F:  "quest",
+	0x0040:  "commat",
+	0x005B:  "lsqb",
+	0x005C:  "bsol",
+	0x005D:  "rsqb",
+	0x005E:  "Hat",
+	0x005F:  "lowbar",
+	0x0060:  "grave",
+	0x007B:  "lcub",
+	0x007C:  "vert",
+	0x007D:  "rcub",
+	0x00A0:  "nbsp",
+	0x00A1:  "iexcl",
+	0x00A2:  "cent",
+	0x00A3:  "pound",
+	0x00A4:  "curren",
+	0x00A5:  "yen",
+	0x00A6:  "brvbar",
+	0x00A7:  "sect",
+	0x00A8:  "uml",
+	0x00A9:  "copy",
+	0x00AA:  "ordf",
+	0x00AB:  "laquo",
+	0x00AC:  "not",
+	0x00AD:  "shy",
+	0x00AE:  "reg",
+	0x00AF:  "macr",
+	0x00B0:  "deg",
+	0x00B1:  "plusmn",
+	0x00B2:  "sup2",
+	0x00B3:  "sup3",
+	0x00B4:  "acute",
+	0x00B5:  "micro",
+	0x00B6:  "para",
+	0x00B7:  "middot",
+	0x00B8:  "cedil",
+	0x00B9:  "sup1",
+	0x00BA:  "ordm",
+	0x00BB:  "raquo",
+	0x00BC:  "frac14",
+	0x00BD:  "frac12",
+	0x00BE:  "frac34",
+	0x00BF:  "iquest",
+	0x00C0:  "Agrave",
+	0x00C1:  "Aacute",
+	0x00C2:  "Acirc",
+	0x00C3:  "Atilde",
+	0x00C4:  "Auml",
+	0x00C5:  "Aring",
+	0x00C6:  "AElig",
+	0x00C7:  "Ccedil",
+	0x00C8:  "Egrave",
+	0x00C9:  "Eacute",
+	0x00CA:  "Ecirc",
+	0x00CB:  "Euml",
+	0x00CC:  "Igrave",
+	0x00CD:  "Iacute",
+	0x00CE:  "Icirc",
+	0x00CF:  "Iuml",
+	0x00D0:  "ETH",
+	0x00D1:  "Ntilde",
+	0x00D2:  "Ograve",
+	0x00D3:  "Oacute",
+	0x00D4:  "Ocirc",
+	0x00D5:  "Otilde",
+	0x00D6:  "Ouml",
+	0x00D7:  "times",
+	0x00D8:  "Oslash",
+	0x00D9:  "Ugrave",
+	0x00DA:  "Uacute",
+	0x00DB:  "Ucirc",
+	0x00DC:  "Uuml",
+	0x00DD:  "Yacute",
+	0x00DE:  "THORN",
+	0x00DF:  "szlig",
+	0x00E0:  "agrave",
+	0x00E1:  "aacute",
+	0x00E2:  "acirc",
+	0x00E3:  "atilde",
+	0x00E4:  "auml",
+	0x00E5:  "aring",
+	0x00E6:  "aelig",
+	0x00E7:  "ccedil",
+	0x00E8:  "egrave",
+	0x00E9:  "eacute",
+	0x00EA:  "ecirc",
+	0x00EB:  "euml",
+	0x00EC:  "igrave",
+	0x00ED:  "iacute",
+	0x00EE:  "icirc",
+	0x00EF:  "iuml",
+	0x00F0:  "eth",
+	0x00F1:  "ntilde",
+	0x00F2:  "ograve",
+	0x00F3:  "oacute",
+	0x00F4:  "ocirc",
+	0x00F5:  "otilde",
+	0x00F6:  "ouml",
+	0x00F7:  "divide",
+	0x00F8:  "oslash",
+	0x00F9:  "ugrave",
+	0x00FA:  "uacute",
+	0x00FB:  "ucirc",
+	0x00FC:  "uuml",
+	0x00FD:  "yacute",
+	0x00FE:  "thorn",
+	0x00FF:  "yuml",
+	0x0100:  "Amacr",
+	0x0101:  "amacr",
+	0x0102:  "Abreve",
+	0x0103:  "abreve",
+	0x0104:  "Aogon",
+	0x0105:  "aogon",
+	0x0106:  "Cacute",
+	0x0107:  "cacute",
+	0x0108:  "Ccirc",
+	0x0109:  "ccirc",
+	0x010A:  "Cdot",
+	0x010B:  "cdot",
+	0x010C:  "Ccaron",
+	0x010D:  "ccaron",
+	0x010E:  "Dcaron",
+	0x010F:  "dcaron",
+	0x0110:  "Dstrok",
+	0x0111:  "dstrok",
+	0x0112:  "Emacr",
+	0x0113:  "emacr",
+	0x0116:  "Edot",
+	0x0117:  "edot",
+	0x0118:  "Eogon",
+	0x0119:  "eogon",
+	0x011A:  "Ecaron",
+	0x011B:  "ecaron",
+	0x011C:  "Gcirc",
+	0x011D:  "gcirc",
+	0x011E:  "Gbreve",
+	0x011F:  "gbreve",
+	0x0120:  "Gdot",
+	0x0121:  "gdot",
+	0x0122:  "Gcedil",
+	0x0124:  "Hcirc",
+	0x0125:  "hcirc",
+	0x0126:  "Hstrok",
+	0x0127:  "hstrok",
+	0x0128:  "Itilde",
+	0x0129:  "itilde",
+	0x012A:  "Imacr",
+	0x012B:  "imacr",
+	0x012E:  "Iogon",
+	0x012F:  "iogon",
+	0x0130:  "Idot",
+	0x0131:  "imath",
+	0x0132:  "IJlig",
+	0x0133:  "ijlig",
+	0x0134:  "Jcirc",
+	0x0135:  "jcirc",
+	0x0136:  "Kcedil",
+	0x0137:  "kcedil",
+	0x0138:  "kgreen",
+	0x0139:  "Lacute",
+	0x013A:  "lacute",
+	0x013B:  "Lcedil",
+	0x013C:  "lcedil",
+	0x013D:  "Lcaron",
+	0x013E:  "lcaron",
+	0x013F:  "Lmidot",
+	0x0140:  "lmidot",
+	0x0141:  "Lstrok",
+	0x0142:  "lstrok",
+	0x0143:  "Nacute",
+	0x0144:  "nacute",
+	0x0145:  "Ncedil",
+	0x0146:  "ncedil",
+	0x0147:  "Ncaron",
+	0x0148:  "ncaron",
+	0x0149:  "napos",
+	0x014A:  "ENG",
+	0x014B:  "eng",
+	0x014C:  "Omacr",
+	0x014D:  "omacr",
+	0x0150:  "Odblac",
+	0x0151:  "odblac",
+	0x0152:  "OElig",
+	0x0153:  "oelig",
+	0x0154:  "Racute",
+	0x0155:  "racute",
+	0x0156:  "Rcedil",
+	0x0157:  "rcedil",
+	0x0158:  "Rcaron",
+	0x0159:  "rcaron",
+	0x015A:  "Sacute",
+	0x015B:  "sacute",
+	0x015C:  "Scirc",
+	0x015D:  "scirc",
+	0x015E:  "Scedil",
+	0x015F:  "scedil",
+	0x0160:  "Scaron",
+	0x0161:  "scaron",
+	0x0162:  "Tcedil",
+	0x0163:  "tcedil",
+	0x0164:  "Tcaron",
+	0x0165:  "tcaron",
+	0x0166:  "Tstrok",
+	0x0167:  "tstrok",
+	0x0168:  "Utilde",
+	0x0169:  "utilde",
+	0x016A:  "Umacr",
+	0x016B:  "umacr",
+	0x016C:  "Ubreve",
+	0x016D:  "ubreve",
+	0x016E:  "Uring",
+	0x016F:  "uring",
+	0x0170:  "Udblac",
+	0x0171:  "udblac",
+	0x0172:  "Uogon",
+	0x0173:  "uogon",
+	0x0174:  "Wcirc",
+	0x0175:  "wcirc",
+	0x0176:  "Ycirc",
+	0x0177:  "ycirc",
+	0x0178:  "Yuml",
+	0x0179:  "Zacute",
+	0x017A:  "zacute",
+	0x017B:  "Zdot",
+	0x017C:  "zdot",
+	0x017D:  "Zcaron",
+	0x017E:  "zcaron",
+	0x0192:  "fnof",
+	0x01B5:  "imped",
+	0x01F5:  "gacute",
+	0x0237:  "jmath",
+	0x02C6:  "circ",
+	0x02C7:  "caron",
+	0x02D8:  "breve",
+	0x02D9:  "dot",
+	0x02DA:  "ring",
+	0x02DB:  "ogon",
+	0x02DC:  "tilde",
+	0x02DD:  "dblac",
+	0x0311:  "DownBreve",
+	0x0391:  "Alpha",
+	0x0392:  "Beta",
+	0x0393:  "Gamma",
+	0x0394:  "Delta",
+	0x0395:  "Epsilon",
+	0x0396:  "Zeta",
+	0x0397:  "Eta",
+	0x0398:  "Theta",
+	0x0399:  "Iota",
+	0x039A:  "Kappa",
+	0x039B:  "Lambda",
+	0x039C:  "Mu",
+	0x039D:  "Nu",
+	0x039E:  "Xi",
+	0x039F:  "Omicron",
+	0x03A0:  "Pi",
+	0x03A1:  "Rho",
+	0x03A3:  "Sigma",
+	0x03A4:  "Tau",
+	0x03A5:  "Upsilon",
+	0x03A6:  "Phi",
+	0x03A7:  "Chi",
+	0x03A8:  "Psi",
+	0x03A9:  "Omega",
+	0x03B1:  "alpha",
+	0x03B2:  "beta",
+	0x03B3:  "gamma",
+	0x03B4:  "delta",
+	0x03B5:  "epsilon",
+	0x03B6:  "zeta",
+	0x03B7:  "eta",
+	0x03B8:  "theta",
+	0x03B9:  "iota",
+	0x03BA:  "kappa",
+	0x03BB:  "lambda",
+	0x03BC:  "mu",
+	0x03BD:  "nu",
+	0x03BE:  "xi",
+	0x03BF:  "omicron",
+	0x03C0:  "pi",
+	0x03C1:  "rho",
+	0x03C2:  "sigmaf",
+	0x03C3:  "sigma",
+	0x03C4:  "tau",
+	0x03C5:  "upsilon",
+	0x03C6:  "phi",
+	0x03C7:  "chi",
+	0x03C8:  "psi",
+	0x03C9:  "omega",
+	0x03D1:  "thetasym",
+	0x03D2:  "upsih",
+	0x03D5:  "phiv",
+	0x03D6:  "piv",
+	0x03DC:  "Gammad",
+	0x03DD:  "gammad",
+	0x03F0:  "kappav",
+	0x03F1:  "rhov",
+	0x03F5:  "epsiv",
+	0x03F6:  "bepsi",
+	0x0401:  "IOcy",
+	0x0402:  "DJcy",
+	0x0403:  "GJcy",
+	0x0404:  "Jukcy",
+	0x0405:  "DScy",
+	0x0406:  "Iukcy",
+	0x0407:  "YIcy",
+	0x0408:  "Jsercy",
+	0x0409:  "LJcy",
+	0x040A:  "NJcy",
+	0x040B:  "TSHcy",
+	0x040C:  "KJcy",
+	0x040E:  "Ubrcy",
+	0x040F:  "DZcy",
+	0x0410:  "Acy",
+	0x0411:  "Bcy",
+	0x0412:  "Vcy",
+	0x0413:  "Gcy",
+	0x0414:  "Dcy",
+	0x0415:  "IEcy",
+	0x0416:  "ZHcy",
+	0x0417:  "Zcy",
+	0x0418:  "Icy",
+	0x0419:  "Jcy",
+	0x041A:  "Kcy",
+	0x041B:  "Lcy",
+	0x041C:  "Mcy",
+	0x041D:  "Ncy",
+	0x041E:  "Ocy",
+	0x041F:  "Pcy",
+	0x0420:  "Rcy",
+	0x0421:  "Scy",
+	0x0422:  "Tcy",
+	0x0423:  "Ucy",
+	0x0424:  "Fcy",
+	0x0425:  "KHcy",
+	0x0426:  "TScy",
+	0x0427:  "CHcy",
+	0x0428:  "SHcy",
+	0x0429:  "SHCHcy",
+	0x042A:  "HARDcy",
+	0x042B:  "Ycy",
+	0x042C:  "SOFTcy",
+	0x042D:  "Ecy",
+	0x042E:  "YUcy",
+	0x042F:  "YAcy",
+	0x0430:  "acy",
+	0x0431:  "bcy",
+	0x0432:  "vcy",
+	0x0433:  "gcy",
+	0x0434:  "dcy",
+	0x0435:  "iecy",
+	0x0436:  "zhcy",
+	0x0437:  "zcy",
+	0x0438:  "icy",
+	0x0439:  "jcy",
+	0x043A:  "kcy",
+	0x043B:  "lcy",
+	0x043C:  "mcy",
+	0x043D:  "ncy",
+	0x043E:  "ocy",
+	0x043F:  "pcy",
+	0x0440:  "rcy",
+	0x0441:  "scy",
+	0x0442:  "tcy",
+	0x0443:  "ucy",
+	0x0444:  "fcy",
+	0x0445:  "khcy",
+	0x0446:  "tscy",
+	0x0447:  "chcy",
+	0x0448:  "shcy",
+	0x0449:  "shchcy",
+	0x044A:  "hardcy",
+	0x044B:  "ycy",
+	0x044C:  "softcy",
+	0x044D:  "ecy",
+	0x044E:  "yucy",
+	0x044F:  "yacy",
+	0x0451:  "iocy",
+	0x0452:  "djcy",
+	0x0453:  "gjcy",
+	0x0454:  "jukcy",
+	0x0455:  "dscy",
+	0x0456:  "iukcy",
+	0x0457:  "yicy",
+	0x0458:  "jsercy",
+	0x0459:  "ljcy",
+	0x045A:  "njcy",
+	0x045B:  "tshcy",
+	0x045C:  "kjcy",
+	0x045E:  "ubrcy",
+	0x045F:  "dzcy",
+	0x2002:  "ensp",
+	0x2003:  "emsp",
+	0x2004:  "emsp13",
+	0x2005:  "emsp14",
+	0x2007:  "numsp",
+	0x2008:  "puncsp",
+	0x2009:  "thinsp",
+	0x200A:  "hairsp",
+	0x200B:  "ZeroWidthSpace",
+	0x200C:  "zwnj",
+	0x200D:  "zwj",
+	0x200E:  "lrm",
+	0x200F:  "rlm",
+	0x2010:  "dash",
+	0x2013:  "ndash",
+	0x2014:  "mdash",
+	0x2015:  "horbar",
+	0x2016:  "Vert",
+	0x2018:  "lsquo",
+	0x2019:  "rsquo",
+	0x201A:  "sbquo",
+	0x201C:  "ldquo",
+	0x201D:  "rdquo",
+	0x201E:  "bdquo",
+	0x2020:  "dagger",
+	0x2021:  "Dagger",
+	0x2022:  "bull",
+	0x2025:  "nldr",
+	0x2026:  "hellip",
+	0x2030:  "permil",
+	0x2031:  "pertenk",
+	0x2032:  "prime",
+	0x2033:  "Prime",
+	0x2034:  "tprime",
+	0x2035:  "bprime",
+	0x2039:  "lsaquo",
+	0x203A:  "rsaquo",
+	0x203E:  "oline",
+	0x2041:  "caret",
+	0x2043:  "hybull",
+	0x2044:  "frasl",
+	0x204F:  "bsemi",
+	0x2057:  "qprime",
+	0x205F:  "MediumSpace",
+	0x2060:  "NoBreak",
+	0x2061:  "af",
+	0x2062:  "it",
+	0x2063:  "ic",
+	0x20AC:  "euro",
+	0x20DB:  "tdot",
+	0x20DC:  "DotDot",
+	0x2102:  "Copf",
+	0x2105:  "incare",
+	0x210A:  "gscr",
+	0x210B:  "Hscr",
+	0x210C:  "Hfr",
+	0x210D:  "Hopf",
+	0x210E:  "planckh",
+	0x210F:  "hbar",
+	0x2110:  "Iscr",
+	0x2111:  "image",
+	0x2112:  "Lscr",
+	0x2113:  "ell",
+	0x2115:  "Nopf",
+	0x2116:  "numero",
+	0x2117:  "copysr",
+	0x2118:  "weierp",
+	0x2119:  "Popf",
+	0x211A:  "Qopf",
+	0x211B:  "Rscr",
+	0x211C:  "real",
+	0x211D:  "Ropf",
+	0x211E:  "rx",
+	0x2122:  "trade",
+	0x2124:  "Zopf",
+	0x2127:  "mho",
+	0x2128:  "Zfr",
+	0x2129:  "iiota",
+	0x212C:  "Bscr",
+	0x212D:  "Cfr",
+	0x212F:  "escr",
+	0x2130:  "Escr",
+	0x2131:  "Fscr",
+	0x2133:  "Mscr",
+	0x2134:  "oscr",
+	0x2135:  "alefsym",
+	0x2136:  "beth",
+	0x2137:  "gimel",
+	0x2138:  "daleth",
+	0x2145:  "DD",
+	0x2146:  "dd",
+	0x2147:  "ee",
+	0x2148:  "ii",
+	0x2153:  "frac13",
+	0x2154:  "frac23",
+	0x2155:  "frac15",
+	0x2156:  "frac25",
+	0x2157:  "frac35",
+	0x2158:  "frac45",
+	0x2159:  "frac16",
+	0x215A:  "frac56",
+	0x215B:  "frac18",
+	0x215C:  "frac38",
+	0x215D:  "frac58",
+	0x215E:  "frac78",
+	0x2190:  "larr",
+	0x2191:  "uarr",
+	0x2192:  "rarr",
+	0x2193:  "darr",
+	0x2194:  "harr",
+	0x2195:  "varr",
+	0x2196:  "nwarr",
+	0x2197:  "nearr",
+	0x2198:  "searr",
+	0x2199:  "swarr",
+	0x219A:  "nlarr",
+	0x219B:  "nrarr",
+	0x219D:  "rarrw",
+	0x219E:  "Larr",
+	0x219F:  "Uarr",
+	0x21A0:  "Rarr",
+	0x21A1:  "Darr",
+	0x21A2:  "larrtl",
+	0x21A3:  "rarrtl",
+	0x21A4:  "mapstoleft",
+	0x21A5:  "mapstoup",
+	0x21A6:  "map",
+	0x21A7:  "mapstodown",
+	0x21A9:  "larrhk",
+	0x21AA:  "rarrhk",
+	0x21AB:  "larrlp",
+	0x21AC:  "rarrlp",
+	0x21AD:  "harrw",
+	0x21AE:  "nharr",
+	0x21B0:  "lsh",
+	0x21B1:  "rsh",
+	0x21B2:  "ldsh",
+	0x21B3:  "rdsh",
+	0x21B5:  "crarr",
+	0x21B6:  "cularr",
+	0x21B7:  "curarr",
+	0x21BA:  "olarr",
+	0x21BB:  "orarr",
+	0x21BC:  "lharu",
+	0x21BD:  "lhard",
+	0x21BE:  "uharr",
+	0x21BF:  "uharl",
+	0x21C0:  "rharu",
+	0x21C1:  "rhard",
+	0x21C2:  "dharr",
+	0x21C3:  "dharl",
+	0x21C4:  "rlarr",
+	0x21C5:  "udarr",
+	0x21C6:  "lrarr",
+	0x21C7:  "llarr",
+	0x21C8:  "uuarr",
+	0x21C9:  "rrarr",
+	0x21CA:  "ddarr",
+	0x21CB:  "lrhar",
+	0x21CC:  "rlhar",
+	0x21CD:  "nlArr",
+	0x21CE:  "nhArr",
+	0x21CF:  "nrArr",
+	0x21D0:  "lArr",
+	0x21D1:  "uArr",
+	0x21D2:  "rArr",
+	0x21D3:  "dArr",
+	0x21D4:  "hArr",
+	0x21D5:  "vArr",
+	0x21D6:  "nwArr",
+	0x21D7:  "neArr",
+	0x21D8:  "seArr",
+	0x21D9:  "swArr",
+	0x21DA:  "lAarr",
+	0x21DB:  "rAarr",
+	0x21DD:  "zigrarr",
+	0x21E4:  "larrb",
+	0x21E5:  "rarrb",
+	0x21F5:  "duarr",
+	0x21FD:  "loarr",
+	0x21FE:  "roarr",
+	0x21FF:  "hoarr",
+	0x2200:  "forall",
+	0x2201:  "comp",
+	0x2202:  "part",
+	0x2203:  "exist",
+	0x2204:  "nexist",
+	0x2205:  "empty",
+	0x2207:  "nabla",
+	0x2208:  "isin",
+	0x2209:  "notin",
+	0x220B:  "ni",
+	0x220C:  "notni",
+	0x220F:  "prod",
+	0x2210:  "coprod",
+	0x2211:  "sum",
+	0x2212:  "minus",
+	0x2213:  "mp",
+	0x2214:  "plusdo",
+	0x2216:  "setmn",
+	0x2217:  "lowast",
+	0x2218:  "compfn",
+	0x221A:  "radic",
+	0x221D:  "prop",
+	0x221E:  "infin",
+	0x221F:  "angrt",
+	0x2220:  "ang",
+	0x2221:  "angmsd",
+	0x2222:  "angsph",
+	0x2223:  "mid",
+	0x2224:  "nmid",
+	0x2225:  "par",
+	0x2226:  "npar",
+	0x2227:  "and",
+	0x2228:  "or",
+	0x2229:  "cap",
+	0x222A:  "cup",
+	0x222B:  "int",
+	0x222C:  "Int",
+	0x222D:  "tint",
+	0x222E:  "oint",
+	0x222F:  "Conint",
+	0x2230:  "Cconint",
+	0x2231:  "cwint",
+	0x2232:  "cwconint",
+	0x2233:  "awconint",
+	0x2234:  "there4",
+	0x2235:  "becaus",
+	0x2236:  "ratio",
+	0x2237:  "Colon",
+	0x2238:  "minusd",
+	0x223A:  "mDDot",
+	0x223B:  "homtht",
+	0x223C:  "sim",
+	0x223D:  "bsim",
+	0x223E:  "ac",
+	0x223F:  "acd",
+	0x2240:  "wr",
+	0x2241:  "nsim",
+	0x2242:  "esim",
+	0x2243:  "sime",
+	0x2244:  "nsime",
+	0x2245:  "cong",
+	0x2246:  "simne",
+	0x2247:  "ncong",
+	0x2248:  "asymp",
+	0x2249:  "nap",
+	0x224A:  "ape",
+	0x224B:  "apid",
+	0x224C:  "bcong",
+	0x224D:  "CupCap",
+	0x224E:  "bump",
+	0x224F:  "bumpe",
+	0x2250:  "doteq",
+	0x2251:  "eDot",
+	0x2252:  "efDot",
+	0x2253:  "erDot",
+	0x2254:  "colone",
+	0x2255:  "ecolon",
+	0x2256:  "ecir",
+	0x2257:  "cire",
+	0x2259:  "wedgeq",
+	0x225A:  "veeeq",
+	0x225C:  "trie",
+	0x225F:  "equest",
+	0x2260:  "ne",
+	0x2261:  "equiv",
+	0x2262:  "nequiv",
+	0x2264:  "le",
+	0x2265:  "ge",
+	0x2266:  "lE",
+	0x2267:  "gE",
+	0x2268:  "lnE",
+	0x2269:  "gnE",
+	0x226A:  "ll",
+	0x226B:  "gg",
+	0x226C:  "twixt",
+	0x226D:  "NotCupCap",
+	0x226E:  "nlt",
+	0x226F:  "ngt",
+	0x2270:  "nle",
+	0x2271:  "nge",
+	0x2272:  "lsim",
+	0x2273:  "gsim",
+	0x2274:  "nlsim",
+	0x2275:  "ngsim",
+	0x2276:  "lg",
+	0x2277:  "gl",
+	0x2278:  "ntlg",
+	0x2279:  "ntgl",
+	0x227A:  "pr",
+	0x227B:  "sc",
+	0x227C:  "prcue",
+	0x227D:  "sccue",
+	0x227E:  "prsim",
+	0x227F:  "scsim",
+	0x2280:  "npr",
+	0x2281:  "nsc",
+	0x2282:  "sub",
+	0x2283:  "sup",
+	0x2284:  "nsub",
+	0x2285:  "nsup",
+	0x2286:  "sube",
+	0x2287:  "supe",
+	0x2288:  "nsube",
+	0x2289:  "nsupe",
+	0x228A:  "subne",
+	0x228B:  "supne",
+	0x228D:  "cupdot",
+	0x228E:  "uplus",
+	0x228F:  "sqsub",
+	0x2290:  "sqsup",
+	0x2291:  "sqsube",
+	0x2292:  "sqsupe",
+	0x2293:  "sqcap",
+	0x2294:  "sqcup",
+	0x2295:  "oplus",
+	0x2296:  "ominus",
+	0x2297:  "otimes",
+	0x2298:  "osol",
+	0x2299:  "odot",
+	0x229A:  "ocir",
+	0x229B:  "oast",
+	0x229D:  "odash",
+	0x229E:  "plusb",
+	0x229F:  "minusb",
+	0x22A0:  "timesb",
+	0x22A1:  "sdotb",
+	0x22A2:  "vdash",
+	0x22A3:  "dashv",
+	0x22A4:  "top",
+	0x22A5:  "bot",
+	0x22A7:  "models",
+	0x22A8:  "vDash",
+	0x22A9:  "Vdash",
+	0x22AA:  "Vvdash",
+	0x22AB:  "VDash",
+	0x22AC:  "nvdash",
+	0x22AD:  "nvDash",
+	0x22AE:  "nVdash",
+	0x22AF:  "nVDash",
+	0x22B0:  "prurel",
+	0x22B2:  "vltri",
+	0x22B3:  "vrtri",
+	0x22B4:  "ltrie",
+	0x22B5:  "rtrie",
+	0x22B6:  "origof",
+	0x22B7:  "imof",
+	0x22B8:  "mumap",
+	0x22B9:  "hercon",
+	0x22BA:  "intcal",
+	0x22BB:  "veebar",
+	0x22BD:  "barvee",
+	0x22BE:  "angrtvb",
+	0x22BF:  "lrtri",
+	0x22C0:  "Wedge",
+	0x22C1:  "Vee",
+	0x22C2:  "xcap",
+	0x22C3:  "xcup",
+	0x22C4:  "diam",
+	0x22C5:  "sdot",
+	0x22C6:  "Star",
+	0x22C7:  "divonx",
+	0x22C8:  "bowtie",
+	0x22C9:  "ltimes",
+	0x22CA:  "rtimes",
+	0x22CB:  "lthree",
+	0x22CC:  "rthree",
+	0x22CD:  "bsime",
+	0x22CE:  "cuvee",
+	0x22CF:  "cuwed",
+	0x22D0:  "Sub",
+	0x22D1:  "Sup",
+	0x22D2:  "Cap",
+	0x22D3:  "Cup",
+	0x22D4:  "fork",
+	0x22D5:  "epar",
+	0x22D6:  "ltdot",
+	0x22D7:  "gtdot",
+	0x22D8:  "Ll",
+	0x22D9:  "Gg",
+	0x22DA:  "leg",
+	0x22DB:  "gel",
+	0x22DE:  "cuepr",
+	0x22DF:  "cuesc",
+	0x22E0:  "nprcue",
+	0x22E1:  "nsccue",
+	0x22E2:  "nsqsube",
+	0x22E3:  "nsqsupe",
+	0x22E6:  "lnsim",
+	0x22E7:  "gnsim",
+	0x22E8:  "prnsim",
+	0x22E9:  "scnsim",
+	0x22EA:  "nltri",
+	0x22EB:  "nrtri",
+	0x22EC:  "nltrie",
+	0x22ED:  "nrtrie",
+	0x22EE:  "vellip",
+	0x22EF:  "ctdot",
+	0x22F0:  "utdot",
+	0x22F1:  "dtdot",
+	0x22F2:  "disin",
+	0x22F3:  "isinsv",
+	0x22F4:  "isins",
+	0x22F5:  "isindot",
+	0x22F6:  "notinvc",
+	0x22F7:  "notinvb",
+	0x22F9:  "isinE",
+	0x22FA:  "nisd",
+	0x22FB:  "xnis",
+	0x22FC:  "nis",
+	0x22FD:  "notnivc",
+	0x22FE:  "notnivb",
+	0x2305:  "barwed",
+	0x2306:  "Barwed",
+	0x2308:  "lceil",
+	0x2309:  "rceil",
+	0x230A:  "lfloor",
+	0x230B:  "rfloor",
+	0x230C:  "drcrop",
+	0x230D:  "dlcrop",
+	0x230E:  "urcrop",
+	0x230F:  "ulcrop",
+	0x2310:  "bnot",
+	0x2312:  "profline",
+	0x2313:  "profsurf",
+	0x2315:  "telrec",
+	0x2316:  "target",
+	0x231C:  "ulcorn",
+	0x231D:  "urcorn",
+	0x231E:  "dlcorn",
+	0x231F:  "drcorn",
+	0x2322:  "frown",
+	0x2323:  "smile",
+	0x232D:  "cylcty",
+	0x232E:  "profalar",
+	0x2336:  "topbot",
+	0x233D:  "ovbar",
+	0x233F:  "solbar",
+	0x237C:  "angzarr",
+	0x23B0:  "lmoust",
+	0x23B1:  "rmoust",
+	0x23B4:  "tbrk",
+	0x23B5:  "bbrk",
+	0x23B6:  "bbrktbrk",
+	0x23DC:  "OverParenthesis",
+	0x23DD:  "UnderParenthesis",
+	0x23DE:  "OverBrace",
+	0x23DF:  "UnderBrace",
+	0x23E2:  "trpezium",
+	0x23E7:  "elinters",
+	0x2423:  "blank",
+	0x24C8:  "oS",
+	0x2500:  "boxh",
+	0x2502:  "boxv",
+	0x250C:  "boxdr",
+	0x2510:  "boxdl",
+	0x2514:  "boxur",
+	0x2518:  "boxul",
+	0x251C:  "boxvr",
+	0x2524:  "boxvl",
+	0x252C:  "boxhd",
+	0x2534:  "boxhu",
+	0x253C:  "boxvh",
+	0x2550:  "boxH",
+	0x2551:  "boxV",
+	0x2552:  "boxdR",
+	0x2553:  "boxDr",
+	0x2554:  "boxDR",
+	0x2555:  "boxdL",
+	0x2556:  "boxDl",
+	0x2557:  "boxDL",
+	0x2558:  "boxuR",
+	0x2559:  "boxUr",
+	0x255A:  "boxUR",
+	0x255B:  "boxuL",
+	0x255C:  "boxUl",
+	0x255D:  "boxUL",
+	0x255E:  "boxvR",
+	0x255F:  "boxVr",
+	0x2560:  "boxVR",
+	0x2561:  "boxvL",
+	0x2562:  "boxVl",
+	0x2563:  "boxVL",
+	0x2564:  "boxHd",
+	0x2565:  "boxhD",
+	0x2566:  "boxHD",
+	0x2567:  "boxHu",
+	0x2568:  "boxhU",
+	0x2569:  "boxHU",
+	0x256A:  "boxvH",
+	0x256B:  "boxVh",
+	0x256C:  "boxVH",
+	0x2580:  "uhblk",
+	0x2584:  "lhblk",
+	0x2588:  "block",
+	0x2591:  "blk14",
+	0x2592:  "blk12",
+	0x2593:  "blk34",
+	0x25A1:  "squ",
+	0x25AA:  "squf",
+	0x25AB:  "EmptyVerySmallSquare",
+	0x25AD:  "rect",
+	0x25AE:  "marker",
+	0x25B1:  "fltns",
+	0x25B3:  "xutri",
+	0x25B4:  "utrif",
+	0x25B5:  "utri",
+	0x25B8:  "rtrif",
+	0x25B9:  "rtri",
+	0x25BD:  "xdtri",
+	0x25BE:  "dtrif",
+	0x25BF:  "dtri",
+	0x25C2:  "ltrif",
+	0x25C3:  "ltri",
+	0x25CA:  "loz",
+	0x25CB:  "cir",
+	0x25EC:  "tridot",
+	0x25EF:  "xcirc",
+	0x25F8:  "ultri",
+	0x25F9:  "urtri",
+	0x25FA:  "lltri",
+	0x25FB:  "EmptySmallSquare",
+	0x25FC:  "FilledSmallSquare",
+	0x2605:  "starf",
+	0x2606:  "star",
+	0x260E:  "phone",
+	0x2640:  "female",
+	0x2642:  "male",
+	0x2660:  "spades",
+	0x2663:  "clubs",
+	0x2665:  "hearts",
+	0x2666:  "diams",
+	0x266A:  "sung",
+	0x266D:  "flat",
+	0x266E:  "natur",
+	0x266F:  "sharp",
+	0x2713:  "check",
+	0x2717:  "cross",
+	0x2720:  "malt",
+	0x2736:  "sext",
+	0x2758:  "VerticalSeparator",
+	0x2772:  "lbbrk",
+	0x2773:  "rbbrk",
+	0x27C8:  "bsolhsub",
+	0x27C9:  "suphsol",
+	0x27E6:  "lobrk",
+	0x27E7:  "robrk",
+	0x27E8:  "lang",
+	0x27E9:  "rang",
+	0x27EA:  "Lang",
+	0x27EB:  "Rang",
+	0x27EC:  "loang",
+	0x27ED:  "roang",
+	0x27F5:  "xlarr",
+	0x27F6:  "xrarr",
+	0x27F7:  "xharr",
+	0x27F8:  "xlArr",
+	0x27F9:  "xrArr",
+	0x27FA:  "xhArr",
+	0x27FC:  "xmap",
+	0x27FF:  "dzigrarr",
+	0x2902:  "nvlArr",
+	0x2903:  "nvrArr",
+	0x2904:  "nvHarr",
+	0x2905:  "Map",
+	0x290C:  "lbarr",
+	0x290D:  "rbarr",
+	0x290E:  "lBarr",
+	0x290F:  "rBarr",
+	0x2910:  "RBarr",
+	0x2911:  "DDotrahd",
+	0x2912:  "UpArrowBar",
+	0x2913:  "DownArrowBar",
+	0x2916:  "Rarrtl",
+	0x2919:  "latail",
+	0x291A:  "ratail",
+	0x291B:  "lAtail",
+	0x291C:  "rAtail",
+	0x291D:  "larrfs",
+	0x291E:  "rarrfs",
+	0x291F:  "larrbfs",
+	0x2920:  "rarrbfs",
+	0x2923:  "nwarhk",
+	0x2924:  "nearhk",
+	0x2925:  "searhk",
+	0x2926:  "swarhk",
+	0x2927:  "nwnear",
+	0x2928:  "toea",
+	0x2929:  "tosa",
+	0x292A:  "swnwar",
+	0x2933:  "rarrc",
+	0x2935:  "cudarrr",
+	0x2936:  "ldca",
+	0x2937:  "rdca",
+	0x2938:  "cudarrl",
+	0x2939:  "larrpl",
+	0x293C:  "curarrm",
+	0x293D:  "cularrp",
+	0x2945:  "rarrpl",
+	0x2948:  "harrcir",
+	0x2949:  "Uarrocir",
+	0x294A:  "lurdshar",
+	0x294B:  "ldrushar",
+	0x294E:  "LeftRightVector",
+	0x294F:  "RightUpDownVector",
+	0x2950:  "DownLeftRightVector",
+	0x2951:  "LeftUpDownVector",
+	0x2952:  "LeftVectorBar",
+	0x2953:  "RightVectorBar",
+	0x2954:  "RightUpVectorBar",
+	0x2955:  "RightDownVectorBar",
+	0x2956:  "DownLeftVectorBar",
+	0x2957:  "DownRightVectorBar",
+	0x2958:  "LeftUpVectorBar",
+	0x2959:  "LeftDownVectorBar",
+	0x295A:  "LeftTeeVector",
+	0x295B:  "RightTeeVector",
+	0x295C:  "RightUpTeeVector",
+	0x295D:  "RightDownTeeVector",
+	0x295E:  "DownLeftTeeVector",
+	0x295F:  "DownRightTeeVector",
+	0x2960:  "LeftUpTeeVector",
+	0x2961:  "LeftDownTeeVector",
+	0x2962:  "lHar",
+	0x2963:  "uHar",
+	0x2964:  "rHar",
+	0x2965:  "dHar",
+	0x2966:  "luruhar",
+	0x2967:  "ldrdhar",
+	0x2968:  "ruluhar",
+	0x2969:  "rdldhar",
+	0x296A:  "lharul",
+	0x296B:  "llhard",
+	0x296C:  "rharul",
+	0x296D:  "lrhard",
+	0x296E:  "udhar",
+	0x296F:  "duhar",
+	0x2970:  "RoundImplies",
+	0x2971:  "erarr",
+	0x2972:  "simrarr",
+	0x2973:  "larrsim",
+	0x2974:  "rarrsim",
+	0x2975:  "rarrap",
+	0x2976:  "ltlarr",
+	0x2978:  "gtrarr",
+	0x2979:  "subrarr",
+	0x297B:  "suplarr",
+	0x297C:  "lfisht",
+	0x297D:  "rfisht",
+	0x297E:  "ufisht",
+	0x297F:  "dfisht",
+	0x2985:  "lopar",
+	0x2986:  "ropar",
+	0x298B:  "lbrke",
+	0x298C:  "rbrke",
+	0x298D:  "lbrkslu",
+	0x298E:  "rbrksld",
+	0x298F:  "lbrksld",
+	0x2990:  "rbrkslu",
+	0x2991:  "langd",
+	0x2992:  "rangd",
+	0x2993:  "lparlt",
+	0x2994:  "rpargt",
+	0x2995:  "gtlPar",
+	0x2996:  "ltrPar",
+	0x299A:  "vzigzag",
+	0x299C:  "vangrt",
+	0x299D:  "angrtvbd",
+	0x29A4:  "ange",
+	0x29A5:  "range",
+	0x29A6:  "dwangle",
+	0x29A7:  "uwangle",
+	0x29A8:  "angmsdaa",
+	0x29A9:  "angmsdab",
+	0x29AA:  "angmsdac",
+	0x29AB:  "angmsdad",
+	0x29AC:  "angmsdae",
+	0x29AD:  "angmsdaf",
+	0x29AE:  "angmsdag",
+	0x29AF:  "angmsdah",
+	0x29B0:  "bemptyv",
+	0x29B1:  "demptyv",
+	0x29B2:  "cemptyv",
+	0x29B3:  "raemptyv",
+	0x29B4:  "laemptyv",
+	0x29B5:  "ohbar",
+	0x29B6:  "omid",
+	0x29B7:  "opar",
+	0x29B9:  "operp",
+	0x29BB:  "olcross",
+	0x29BC:  "odsold",
+	0x29BE:  "olcir",
+	0x29BF:  "ofcir",
+	0x29C0:  "olt",
+	0x29C1:  "ogt",
+	0x29C2:  "cirscir",
+	0x29C3:  "cirE",
+	0x29C4:  "solb",
+	0x29C5:  "bsolb",
+	0x29C9:  "boxbox",
+	0x29CD:  "trisb",
+	0x29CE:  "rtriltri",
+	0x29CF:  "LeftTriangleBar",
+	0x29D0:  "RightTriangleBar",
+	0x29DC:  "iinfin",
+	0x29DD:  "infintie",
+	0x29DE:  "nvinfin",
+	0x29E3:  "eparsl",
+	0x29E4:  "smeparsl",
+	0x29E5:  "eqvparsl",
+	0x29EB:  "lozf",
+	0x29F4:  "RuleDelayed",
+	0x29F6:  "dsol",
+	0x2A00:  "xodot",
+	0x2A01:  "xoplus",
+	0x2A02:  "xotime",
+	0x2A04:  "xuplus",
+	0x2A06:  "xsqcup",
+	0x2A0C:  "qint",
+	0x2A0D:  "fpartint",
+	0x2A10:  "cirfnint",
+	0x2A11:  "awint",
+	0x2A12:  "rppolint",
+	0x2A13:  "scpolint",
+	0x2A14:  "npolint",
+	0x2A15:  "pointint",
+	0x2A16:  "quatint",
+	0x2A17:  "intlarhk",
+	0x2A22:  "pluscir",
+	0x2A23:  "plusacir",
+	0x2A24:  "simplus",
+	0x2A25:  "plusdu",
+	0x2A26:  "plussim",
+	0x2A27:  "plustwo",
+	0x2A29:  "mcomma",
+	0x2A2A:  "minusdu",
+	0x2A2D:  "loplus",
+	0x2A2E:  "roplus",
+	0x2A2F:  "Cross",
+	0x2A30:  "timesd",
+	0x2A31:  "timesbar",
+	0x2A33:  "smashp",
+	0x2A34:  "lotimes",
+	0x2A35:  "rotimes",
+	0x2A36:  "otimesas",
+	0x2A37:  "Otimes",
+	0x2A38:  "odiv",
+	0x2A39:  "triplus",
+	0x2A3A:  "triminus",
+	0x2A3B:  "tritime",
+	0x2A3C:  "iprod",
+	0x2A3F:  "amalg",
+	0x2A40:  "capdot",
+	0x2A42:  "ncup",
+	0x2A43:  "ncap",
+	0x2A44:  "capand",
+	0x2A45:  "cupor",
+	0x2A46:  "cupcap",
+	0x2A47:  "capcup",
+	0x2A48:  "cupbrcap",
+	0x2A49:  "capbrcup",
+	0x2A4A:  "cupcup",
+	0x2A4B:  "capcap",
+	0x2A4C:  "ccups",
+	0x2A4D:  "ccaps",
+	0x2A50:  "ccupssm",
+	0x2A53:  "And",
+	0x2A54:  "Or",
+	0x2A55:  "andand",
+	0x2A56:  "oror",
+	0x2A57:  "orslope",
+	0x2A58:  "andslope",
+	0x2A5A:  "andv",
+	0x2A5B:  "orv",
+	0x2A5C:  "andd",
+	0x2A5D:  "ord",
+	0x2A5F:  "wedbar",
+	0x2A66:  "sdote",
+	0x2A6A:  "simdot",
+	0x2A6D:  "congdot",
+	0x2A6E:  "easter",
+	0x2A6F:  "apacir",
+	0x2A70:  "apE",
+	0x2A71:  "eplus",
+	0x2A72:  "pluse",
+	0x2A73:  "Esim",
+	0x2A74:  "Colone",
+	0x2A75:  "Equal",
+	0x2A77:  "eDDot",
+	0x2A78:  "equivDD",
+	0x2A79:  "ltcir",
+	0x2A7A:  "gtcir",
+	0x2A7B:  "ltquest",
+	0x2A7C:  "gtquest",
+	0x2A7D:  "les",
+	0x2A7E:  "ges",
+	0x2A7F:  "lesdot",
+	0x2A80:  "gesdot",
+	0x2A81:  "lesdoto",
+	0x2A82:  "gesdoto",
+	0x2A83:  "lesdotor",
+	0x2A84:  "gesdotol",
+	0x2A85:  "lap",
+	0x2A86:  "gap",
+	0x2A87:  "lne",
+	0x2A88:  "gne",
+	0x2A89:  "lnap",
+	0x2A8A:  "gnap",
+	0x2A8B:  "lEg",
+	0x2A8C:  "gEl",
+	0x2A8D:  "lsime",
+	0x2A8E:  "gsime",
+	0x2A8F:  "lsimg",
+	0x2A90:  "gsiml",
+	0x2A91:  "lgE",
+	0x2A92:  "glE",
+	0x2A93:  "lesges",
+	0x2A94:  "gesles",
+	0x2A95:  "els",
+	0x2A96:  "egs",
+	0x2A97:  "elsdot",
+	0x2A98:  "egsdot",
+	0x2A99:  "el",
+	0x2A9A:  "eg",
+	0x2A9D:  "siml",
+	0x2A9E:  "simg",
+	0x2A9F:  "simlE",
+	0x2AA0:  "simgE",
+	0x2AA1:  "LessLess",
+	0x2AA2:  "GreaterGreater",
+	0x2AA4:  "glj",
+	0x2AA5:  "gla",
+	0x2AA6:  "ltcc",
+	0x2AA7:  "gtcc",
+	0x2AA8:  "lescc",
+	0x2AA9:  "gescc",
+	0x2AAA:  "smt",
+	0x2AAB:  "lat",
+	0x2AAC:  "smte",
+	0x2AAD:  "late",
+	0x2AAE:  "bumpE",
+	0x2AAF:  "pre",
+	0x2AB0:  "sce",
+	0x2AB3:  "prE",
+	0x2AB4:  "scE",
+	0x2AB5:  "prnE",
+	0x2AB6:  "scnE",
+	0x2AB7:  "prap",
+	0x2AB8:  "scap",
+	0x2AB9:  "prnap",
+	0x2ABA:  "scnap",
+	0x2ABB:  "Pr",
+	0x2ABC:  "Sc",
+	0x2ABD:  "subdot",
+	0x2ABE:  "supdot",
+	0x2ABF:  "subplus",
+	0x2AC0:  "supplus",
+	0x2AC1:  "submult",
+	0x2AC2:  "supmult",
+	0x2AC3:  "subedot",
+	0x2AC4:  "supedot",
+	0x2AC5:  "subE",
+	0x2AC6:  "supE",
+	0x2AC7:  "subsim",
+	0x2AC8:  "supsim",
+	0x2ACB:  "subnE",
+	0x2ACC:  "supnE",
+	0x2ACF:  "csub",
+	0x2AD0:  "csup",
+	0x2AD1:  "csube",
+	0x2AD2:  "csupe",
+	0x2AD3:  "subsup",
+	0x2AD4:  "supsub",
+	0x2AD5:  "subsub",
+	0x2AD6:  "supsup",
+	0x2AD7:  "suphsub",
+	0x2AD8:  "supdsub",
+	0x2AD9:  "forkv",
+	0x2ADA:  "topfork",
+	0x2ADB:  "mlcp",
+	0x2AE4:  "Dashv",
+	0x2AE6:  "Vdashl",
+	0x2AE7:  "Barv",
+	0x2AE8:  "vBar",
+	0x2AE9:  "vBarv",
+	0x2AEB:  "Vbar",
+	0x2AEC:  "Not",
+	0x2AED:  "bNot",
+	0x2AEE:  "rnmid",
+	0x2AEF:  "cirmid",
+	0x2AF0:  "midcir",
+	0x2AF1:  "topcir",
+	0x2AF2:  "nhpar",
+	0x2AF3:  "parsim",
+	0x2AFD:  "parsl",
+	0xFB00:  "fflig",
+	0xFB01:  "filig",
+	0xFB02:  "fllig",
+	0xFB03:  "ffilig",
+	0xFB04:  "ffllig",
+	0x1D49C: "Ascr",
+	0x1D49E: "Cscr",
+	0x1D49F: "Dscr",
+	0x1D4A2: "Gscr",
+	0x1D4A5: "Jscr",
+	0x1D4A6: "Kscr",
+	0x1D4A9: "Nscr",
+	0x1D4AA: "Oscr",
+	0x1D4AB: "Pscr",
+	0x1D4AC: "Qscr",
+	0x1D4AE: "Sscr",
+	0x1D4AF: "Tscr",
+	0x1D4B0: "Uscr",
+	0x1D4B1: "Vscr",
+	0x1D4B2: "Wscr",
+	0x1D4B3: "Xscr",
+	0x1D4B4: "Yscr",
+	0x1D4B5: "Zscr",
+	0x1D4B6: "ascr",
+	0x1D4B7: "bscr",
+	0x1D4B8: "cscr",
+	0x1D4B9: "dscr",
+	0x1D4BB: "fscr",
+	0x1D4BD: "hscr",
+	0x1D4BE: "iscr",
+	0x1D4BF: "jscr",
+	0x1D4C0: "kscr",
+	0x1D4C1: "lscr",
+	0x1D4C2: "mscr",
+	0x1D4C3: "nscr",
+	0x1D4C5: "pscr",
+	0x1D4C6: "qscr",
+	0x1D4C7: "rscr",
+	0x1D4C8: "sscr",
+	0x1D4C9: "tscr",
+	0x1D4CA: "uscr",
+	0x1D4CB: "vscr",
+	0x1D4CC: "wscr",
+	0x1D4CD: "xscr",
+	0x1D4CE: "yscr",
+	0x1D4CF: "zscr",
+	0x1D504: "Afr",
+	0x1D505: "Bfr",
+	0x1D507: "Dfr",
+	0x1D508: "Efr",
+	0x1D509: "Ffr",
+	0x1D50A: "Gfr",
+	0x1D50D: "Jfr",
+	0x1D50E: "Kfr",
+	0x1D50F: "Lfr",
+	0x1D510: "Mfr",
+	0x1D511: "Nfr",
+	0x1D512: "Ofr",
+	0x1D513: "Pfr",
+	0x1D514: "Qfr",
+	0x1D516: "Sfr",
+	0x1D517: "Tfr",
+	0x1D518: "Ufr",
+	0x1D519: "Vfr",
+	0x1D51A: "Wfr",
+	0x1D51B: "Xfr",
+	0x1D51C: "Yfr",
+	0x1D51E: "afr",
+	0x1D51F: "bfr",
+	0x1D520: "cfr",
+	0x1D521: "dfr",
+	0x1D522: "efr",
+	0x1D523: "ffr",
+	0x1D524: "gfr",
+	0x1D525: "hfr",
+	0x1D526: "ifr",
+	0x1D527: "jfr",
+	0x1D528: "kfr",
+	0x1D529: "lfr",
+	0x1D52A: "mfr",
+	0x1D52B: "nfr",
+	0x1D52C: "ofr",
+	0x1D52D: "pfr",
+	0x1D52E: "qfr",
+	0x1D52F: "rfr",
+	0x1D530: "sfr",
+	0x1D531: "tfr",
+	0x1D532: "ufr",
+	0x1D533: "vfr",
+	0x1D534: "wfr",
+	0x1D535: "xfr",
+	0x1D536: "yfr",
+	0x1D537: "zfr",
+	0x1D538: "Aopf",
+	0x1D539: "Bopf",
+	0x1D53B: "Dopf",
+	0x1D53C: "Eopf",
+	0x1D53D: "Fopf",
+	0x1D53E: "Gopf",
+	0x1D540: "Iopf",
+	0x1D541: "Jopf",
+	0x1D542: "Kopf",
+	0x1D543: "Lopf",
+	0x1D544: "Mopf",
+	0x1D546: "Oopf",
+	0x1D54A: "Sopf",
+	0x1D54B: "Topf",
+	0x1D54C: "Uopf",
+	0x1D54D: "Vopf",
+	0x1D54E: "Wopf",
+	0x1D54F: "Xopf",
+	0x1D550: "Yopf",
+	0x1D552: "aopf",
+	0x1D553: "bopf",
+	0x1D554: "copf",
+	0x1D555: "dopf",
+	0x1D556: "eopf",
+	0x1D557: "fopf",
+	0x1D558: "gopf",
+	0x1D559: "hopf",
+	0x1D55A: "iopf",
+	0x1D55B: "jopf",
+	0x1D55C: "kopf",
+	0x1D55D: "lopf",
+	0x1D55E: "mopf",
+	0x1D55F: "nopf",
+	0x1D560: "oopf",
+	0x1D561: "popf",
+	0x1D562: "qopf",
+	0x1D563: "ropf",
+	0x1D564: "sopf",
+	0x1D565: "topf",
+	0x1D566: "uopf",
+	0x1D567: "vopf",
+	0x1D568: "wopf",
+	0x1D569: "xopf",
+	0x1D56A: "yopf",
+	0x1D56B: "zopf",
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var (
+	errMalformedInput = errors.New("malformed input")
+	errOutOfRange     = errors.New("codepoint out of range")
+	errSurrogate      = errors.New("surrogate codepoint")
+	errUnassigned     = errors.New("unassigned codepoint")
+	errUnknownRange   = errors.New("unknown range")
+	errNotFound       = errors.New("page not found")
+	errInputTooLong   = errors.New("input too long")
+	errNotEmoji       = errors.New("not an emoji")
+)
+
+// suggestion is a link offered on error pages.
+type suggestion struct {
+	Label string `json:"label"`
+	Href  string `json:"href"`
+}
+
+// inputError explains why a request could not be answered. It wraps one of
+// the errors above, which decides the status code.
+type inputError struct {
+	err         error
+	Input       string
+	Suggestions []suggestion
+}
+
+func (e *inputError) Error() string {
+	return fmt.Sprintf("%v: %q", e.err, e.Input)
+}
+
+func (e *inputError) Unwrap() error {
+	return e.err
+}
+
+func (e *inputError) status() int {
+	switch {
+	case errors.Is(e.err, errMalformedInput):
+		return http.StatusBadRequest
+	case errors.Is(e.err, errInputTooLong):
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusNotFound
+}
+
+type errorData struct {
+	UnicodeVersion string
+
+	Status      int
+	StatusText  string
+	Message     string
+	Input       string
+	Suggestions []suggestion
+}
+
+// errorStatus returns the status code for err, 500 for anything that is not
+// an inputError.
+func errorStatus(err error) int {
+	var inputErr *inputError
+	if errors.As(err, &inputErr) {
+		return inputErr.status()
+	}
+	return http.StatusInternalServerError
+}
+
+func serveError(writer http.ResponseWriter, request *http.Request, err error, timer time.Time) {
+	if wantsJSON(request) || strings.HasPrefix(request.URL.Path, "/api/") {
+		serveJSONError(writer, request, err, timer)
+		return
+	}
+
+	status := errorStatus(err)
+	data := errorData{
+		UnicodeVersion: unicodeVersion(),
+
+		Status:     status,
+		StatusText: http.StatusText(status),
+		Message:    err.Error(),
+	}
+
+	var inputErr *inputError
+	if errors.As(err, &inputErr) {
+		data.Message = inputErr.err.Error()
+		data.Input = inputErr.Input
+		data.Suggestions = inputErr.Suggestions
+	}
+
+	writer = setHeaders(writer)
+	writer.Header().Set("Cache-Control", "no-cache")
+	writer.Header().Del("ETag")
+
+	templateFiles := []string{
+		"./template/base.template.html",
+		"./template/error.template.html",
+	}
+
+	serveFilesFromTemplateWithStatus(writer, request, templateFiles, data, status, timer)
+}
+
+// validateCodepoint rejects codepoints that have nothing to show.
+func validateCodepoint(codepoint rune, input string) error {
+	switch {
+	case codepoint < 0 || codepoint > unicode.MaxRune:
+		return &inputError{errOutOfRange, input, []suggestion{
+			{"U+10FFFF, the last codepoint", "/cp/U+10FFFF"},
+			{"a random codepoint", "/random"},
+		}}
+	case unicode.Is(unicode.Cs, codepoint):
+		return &inputError{errSurrogate, input, []suggestion{
+			{"High Surrogates", "/block/high_surrogates"},
+			{"Low Surrogates", "/block/low_surrogates"},
+		}}
+	case getGeneralCategory(codepoint) == "Cn" && !hasProperty(codepoint, "Noncharacter_Code_Point"):
+		// noncharacters are Cn too, but permanently reserved with
+		// properties of their own
+		return &inputError{errUnassigned, input, neighbourSuggestions(codepoint)}
+	}
+	return nil
+}
+
+// neighbourSuggestions links the closest assigned codepoints around an
+// unassigned one, and the block it sits in.
+func neighbourSuggestions(codepoint rune) (suggestions []suggestion) {
+	if previous, ok := previousAssigned(codepoint); ok {
+		suggestions = append(suggestions, suggestion{fmt.Sprintf("previous assigned, %U", previous), fmt.Sprintf("/cp/%U", previous)})
+	}
+	if next, ok := nextAssigned(codepoint); ok {
+		suggestions = append(suggestions, suggestion{fmt.Sprintf("next assigned, %U", next), fmt.Sprintf("/cp/%U", next)})
+	}
+	if block, ok := getBlock(codepoint); ok {
+		suggestions = append(suggestions, suggestion{block.value, "/block/" + blockSlug(block.value)})
+	}
+	return
+}
+
+// rangeSuggestions offers the ranges and blocks whose names contain input.
+func rangeSuggestions(input string) (suggestions []suggestion) {
+	loose := looseRangeName(input)
+	if loose == "" {
+		return nil
+	}
+
+	var names []string
+	for name := range getRangeRegistry().entries {
+		if strings.Contains(looseRangeName(name), loose) && !hiddenFromIndex[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		suggestions = append(suggestions, suggestion{name, "/range/" + name})
+	}
+
+	for _, block := range blockList() {
+		if strings.Contains(looseBlockName(block.value), loose) {
+			suggestions = append(suggestions, suggestion{block.value, "/block/" + blockSlug(block.value)})
+		}
+	}
+
+	if len(suggestions) > 10 {
+		suggestions = suggestions[:10]
+	}
+	return
+}
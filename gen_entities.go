@@ -0,0 +1,135 @@
+//go:build ignore
+
+// gen_entities writes entitydata.go, the reverse of the HTML named character
+// references of a copy of https://html.spec.whatwg.org/entities.json:
+//
+//	go run gen_entities.go -entities ./entities.json
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+// entity is a value of entities.json, keyed by the reference with its & and,
+// unless it is one of the legacy ones, its ;.
+type entity struct {
+	Codepoints []rune `json:"codepoints"`
+	Characters string `json:"characters"`
+}
+
+// html4Entities are the references of HTML 4.01, each defined for exactly
+// its codepoint. They win over the later aliases, some of which name another
+// character that normalizes to the codepoint, like &angst; for U+00C5 or
+// &ohm; for U+03A9.
+var html4Entities = map[string]bool{}
+
+func init() {
+	for _, name := range strings.Fields(`
+		quot amp lt gt nbsp iexcl cent pound curren yen brvbar sect uml copy
+		ordf laquo not shy reg macr deg plusmn sup2 sup3 acute micro para
+		middot cedil sup1 ordm raquo frac14 frac12 frac34 iquest Agrave Aacute
+		Acirc Atilde Auml Aring AElig Ccedil Egrave Eacute Ecirc Euml Igrave
+		Iacute Icirc Iuml ETH Ntilde Ograve Oacute Ocirc Otilde Ouml times
+		Oslash Ugrave Uacute Ucirc Uuml Yacute THORN szlig agrave aacute acirc
+		atilde auml aring aelig ccedil egrave eacute ecirc euml igrave iacute
+		icirc iuml eth ntilde ograve oacute ocirc otilde ouml divide oslash
+		ugrave uacute ucirc uuml yacute thorn yuml OElig oelig Scaron scaron
+		Yuml fnof circ tilde Alpha Beta Gamma Delta Epsilon Zeta Eta Theta Iota
+		Kappa Lambda Mu Nu Xi Omicron Pi Rho Sigma Tau Upsilon Phi Chi Psi
+		Omega alpha beta gamma delta epsilon zeta eta theta iota kappa lambda
+		mu nu xi omicron pi rho sigmaf sigma tau upsilon phi chi psi omega
+		thetasym upsih piv ensp emsp thinsp zwnj zwj lrm rlm ndash mdash lsquo
+		rsquo sbquo ldquo rdquo bdquo dagger Dagger bull hellip permil prime
+		Prime lsaquo rsaquo oline frasl euro image weierp real trade alefsym
+		larr uarr rarr darr harr crarr lArr uArr rArr dArr hArr forall part
+		exist empty nabla isin notin ni prod sum minus lowast radic prop infin
+		ang and or cap cup int there4 sim cong asymp ne equiv le ge sub sup
+		nsub sube supe oplus otimes sdot lceil rceil lfloor rfloor lang rang
+		loz spades clubs hearts diams
+	`) {
+		html4Entities[name] = true
+	}
+}
+
+// preferred orders entity names for the same codepoint: the one of HTML 4.01
+// first, then the shortest one, lowercase before uppercase.
+func preferred(a, b string) bool {
+	if html4Entities[a] != html4Entities[b] {
+		return html4Entities[a]
+	}
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	if (strings.ToLower(a) == a) != (strings.ToLower(b) == b) {
+		return strings.ToLower(a) == a
+	}
+	return a < b
+}
+
+func main() {
+	entitiesPath := flag.String("entities", "./entities.json", "copy of the WHATWG entities.json")
+	flag.Parse()
+
+	source, err := os.ReadFile(*entitiesPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var entities map[string]entity
+	if err := json.Unmarshal(source, &entities); err != nil {
+		log.Fatal(err)
+	}
+
+	names := map[rune]string{}
+	for reference, entity := range entities {
+		// the legacy references without ; repeat ones with it, and a few
+		// references stand for two codepoints
+		if !strings.HasPrefix(reference, "&") || !strings.HasSuffix(reference, ";") || len(entity.Codepoints) != 1 {
+			continue
+		}
+		if string(entity.Codepoints) != entity.Characters {
+			log.Fatalf("%s: codepoints %U are not %q", reference, entity.Codepoints, entity.Characters)
+		}
+		name, codepoint := strings.TrimSuffix(strings.TrimPrefix(reference, "&"), ";"), entity.Codepoints[0]
+		if other, ok := names[codepoint]; !ok || preferred(name, other) {
+			names[codepoint] = name
+		}
+	}
+	if len(names) == 0 {
+		log.Fatalf("%s: no entities found", *entitiesPath)
+	}
+
+	var codepoints []rune
+	for codepoint := range names {
+		codepoints = append(codepoints, codepoint)
+	}
+	sort.Slice(codepoints, func(i, j int) bool { return codepoints[i] < codepoints[j] })
+
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_entities.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "// htmlEntityNames maps codepoints to their preferred HTML named character")
+	fmt.Fprintln(&buffer, "// reference, without the leading & and trailing ;.")
+	fmt.Fprintln(&buffer, "var htmlEntityNames = map[rune]string{")
+	for _, codepoint := range codepoints {
+		fmt.Fprintf(&buffer, "\t0x%04X: %q,\n", codepoint, names[codepoint])
+	}
+	fmt.Fprintln(&buffer, "}")
+
+	formatted, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("entitydata.go", formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,708 @@
+//go:build ignore
+
+// gen_ucd writes the built-in fallback tables (unicodedata.go,
+// blockdata.go, aliasdata.go, mirrordata.go, emojidata.go, breakdata.go,
+// agedata.go, scriptdata.go, scriptextdata.go, propertydata.go, widthdata.go,
+// bididata.go, namedata.go, confusabledata.go and versiondata.go) from a
+// copy of the Unicode Character Database with the emoji-test.txt of the
+// latest emoji release copied into its emoji directory and the
+// confusables.txt of the security data next to UnicodeData.txt:
+//
+//	go run gen_ucd.go -ucd ./ucd
+//
+// Every file has to come from the same Unicode release, no older than the
+// one of the Go toolchain, and the site reports that release as its Unicode
+// version when it runs without a UCD. emoji-test.txt and NamedSequences.txt
+// may lag behind, their versions are reported with the data they hold.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	versionPattern      = regexp.MustCompile(`^#\s*\w+-(\d+\.\d+\.\d+)\.txt`)
+	emojiVersionPattern = regexp.MustCompile(`^#\s*Version:\s*(\S+)`)
+	emojiTestPattern    = regexp.MustCompile(`^([0-9A-F ]+);\s*([a-z-]+)\s*#\s*\S+\s+(E\d+\.\d+)\s+(.*)$`)
+
+	// fileVersions is the version of every file read, so releases are not
+	// mixed by accident.
+	fileVersions = map[string]string{}
+	// separateVersions are the files whose version is reported on its own.
+	separateVersions = map[string]bool{}
+)
+
+func main() {
+	ucdDirectory := flag.String("ucd", "./ucd", "directory holding the UCD text files")
+	flag.Parse()
+
+	generateUnicodeData(filepath.Join(*ucdDirectory, "UnicodeData.txt"), "unicodedata.go")
+	generateBlocks(filepath.Join(*ucdDirectory, "Blocks.txt"), "blockdata.go")
+	generateAliases(
+		filepath.Join(*ucdDirectory, "PropertyValueAliases.txt"),
+		filepath.Join(*ucdDirectory, "PropertyAliases.txt"),
+		"aliasdata.go",
+	)
+	generateMirrors(
+		filepath.Join(*ucdDirectory, "BidiMirroring.txt"),
+		filepath.Join(*ucdDirectory, "extracted", "DerivedBinaryProperties.txt"),
+		filepath.Join(*ucdDirectory, "BidiBrackets.txt"),
+		"mirrordata.go",
+	)
+	generateEmoji(
+		filepath.Join(*ucdDirectory, "emoji", "emoji-data.txt"),
+		[]string{
+			filepath.Join(*ucdDirectory, "emoji", "emoji-sequences.txt"),
+			filepath.Join(*ucdDirectory, "emoji", "emoji-zwj-sequences.txt"),
+		},
+		filepath.Join(*ucdDirectory, "emoji", "emoji-test.txt"),
+		"emojidata.go",
+	)
+	generateBreaks(
+		filepath.Join(*ucdDirectory, "auxiliary", "GraphemeBreakProperty.txt"),
+		filepath.Join(*ucdDirectory, "auxiliary", "WordBreakProperty.txt"),
+		filepath.Join(*ucdDirectory, "DerivedCoreProperties.txt"),
+		"breakdata.go",
+	)
+	generateAges(filepath.Join(*ucdDirectory, "DerivedAge.txt"), "agedata.go")
+	generateRangeList(filepath.Join(*ucdDirectory, "Scripts.txt"), "scriptdata.go", "Scripts",
+		"is used when no Scripts.txt is found in ucdDirectory.")
+	generateScriptExtensions(filepath.Join(*ucdDirectory, "ScriptExtensions.txt"), "scriptextdata.go")
+	generateRangeList(filepath.Join(*ucdDirectory, "PropList.txt"), "propertydata.go", "Properties",
+		"is used when no PropList.txt is found in ucdDirectory.")
+	generateRangeList(filepath.Join(*ucdDirectory, "EastAsianWidth.txt"), "widthdata.go", "EastAsianWidths",
+		"is used when no EastAsianWidth.txt is found in ucdDirectory. Codepoints it\n// leaves out are Neutral.")
+	generateRangeList(filepath.Join(*ucdDirectory, "extracted", "DerivedBidiClass.txt"), "bididata.go", "BidiClasses",
+		"holds the Bidi_Class of every codepoint, unassigned ones\n// included, and is used when no UnicodeData.txt is found in ucdDirectory.\n// Codepoints it leaves out are L.")
+	generateNames(
+		filepath.Join(*ucdDirectory, "NameAliases.txt"),
+		filepath.Join(*ucdDirectory, "NamedSequences.txt"),
+		"namedata.go",
+	)
+	generateConfusables(filepath.Join(*ucdDirectory, "confusables.txt"), "confusabledata.go")
+	generateVersion("versiondata.go")
+}
+
+// readUCDFile returns the version and the semicolon separated fields of
+// every data line of a UCD file.
+func readUCDFile(path string) (version string, lines [][]string) {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if match := versionPattern.FindStringSubmatch(line); match != nil && version == "" {
+			version = match[1]
+		}
+		// the emoji files name their version on a line of its own
+		if match := emojiVersionPattern.FindStringSubmatch(line); match != nil && version == "" {
+			version = match[1]
+		}
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		lines = append(lines, fields)
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	fileVersions[path] = version
+	return version, lines
+}
+
+func writeSource(outputPath string, buffer *bytes.Buffer) {
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(outputPath, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generateBlocks(blocksPath string, outputPath string) {
+	version, lines := readUCDFile(blocksPath)
+
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_ucd.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintf(&buffer, "// builtinBlocksVersion is the Unicode version of builtinBlocks.\n")
+	fmt.Fprintf(&buffer, "const builtinBlocksVersion = %q\n\n", version)
+	fmt.Fprintf(&buffer, "// builtinBlocks is used when no Blocks.txt is found in ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinBlocks = []ucdRange{\n")
+	for _, fields := range lines {
+		lo, hi, _ := strings.Cut(fields[0], "..")
+		fmt.Fprintf(&buffer, "\t{0x%s, 0x%s, %q},\n", lo, hi, fields[1])
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	writeSource(outputPath, &buffer)
+}
+
+func generateAliases(aliasesPath string, propertyAliasesPath string, outputPath string) {
+	version, lines := readUCDFile(aliasesPath)
+	_, propertyLines := readUCDFile(propertyAliasesPath)
+
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_ucd.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintf(&buffer, "// builtinAliasesVersion is the Unicode version of builtinAliases and\n")
+	fmt.Fprintf(&buffer, "// builtinPropertyAliases.\n")
+	fmt.Fprintf(&buffer, "const builtinAliasesVersion = %q\n\n", version)
+	fmt.Fprintf(&buffer, "// builtinAliases holds the Script and General_Category value aliases and\n")
+	fmt.Fprintf(&buffer, "// is used when no PropertyValueAliases.txt is found in ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinAliases = []propertyValueAlias{\n")
+	for _, fields := range lines {
+		if fields[0] != "sc" && fields[0] != "gc" {
+			continue
+		}
+		fmt.Fprintf(&buffer, "\t{%q, %#v},\n", fields[0], fields[1:])
+	}
+	fmt.Fprintf(&buffer, "}\n\n")
+	fmt.Fprintf(&buffer, "// builtinPropertyAliases holds the property aliases, short name first,\n")
+	fmt.Fprintf(&buffer, "// and is used when no PropertyAliases.txt is found in ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinPropertyAliases = [][]string{\n")
+	for _, fields := range propertyLines {
+		fmt.Fprintf(&buffer, "\t{%q", fields[0])
+		for _, field := range fields[1:] {
+			fmt.Fprintf(&buffer, ", %q", field)
+		}
+		fmt.Fprintf(&buffer, "},\n")
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	writeSource(outputPath, &buffer)
+}
+
+func generateMirrors(mirroringPath string, binaryPropertiesPath string, bracketsPath string, outputPath string) {
+	version, mirroringLines := readUCDFile(mirroringPath)
+	_, propertyLines := readUCDFile(binaryPropertiesPath)
+	_, bracketLines := readUCDFile(bracketsPath)
+
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_ucd.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintf(&buffer, "// builtinMirrorsVersion is the Unicode version of builtinMirrors,\n")
+	fmt.Fprintf(&buffer, "// builtinBidiMirrored and builtinBrackets.\n")
+	fmt.Fprintf(&buffer, "const builtinMirrorsVersion = %q\n\n", version)
+	fmt.Fprintf(&buffer, "// builtinMirrors is used when no BidiMirroring.txt is found in ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinMirrors = map[rune]rune{\n")
+	for _, fields := range mirroringLines {
+		fmt.Fprintf(&buffer, "\t0x%s: 0x%s,\n", fields[0], fields[1])
+	}
+	fmt.Fprintf(&buffer, "}\n\n")
+	fmt.Fprintf(&buffer, "// builtinBidiMirrored lists the Bidi_Mirrored codepoints and is used when\n")
+	fmt.Fprintf(&buffer, "// no UnicodeData.txt is found in ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinBidiMirrored = []ucdRange{\n")
+	for _, fields := range propertyLines {
+		if fields[1] != "Bidi_Mirrored" {
+			continue
+		}
+		lo, hi, isRange := strings.Cut(fields[0], "..")
+		if !isRange {
+			hi = lo
+		}
+		fmt.Fprintf(&buffer, "\t{0x%s, 0x%s, \"\"},\n", lo, hi)
+	}
+	fmt.Fprintf(&buffer, "}\n\n")
+	fmt.Fprintf(&buffer, "// builtinBrackets is used when no BidiBrackets.txt is found in ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinBrackets = map[rune]bidiBracket{\n")
+	for _, fields := range bracketLines {
+		fmt.Fprintf(&buffer, "\t0x%s: {0x%s, %t},\n", fields[0], fields[1], fields[2] == "o")
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	writeSource(outputPath, &buffer)
+}
+
+func generateEmoji(dataPath string, sequencesPaths []string, testPath string, outputPath string) {
+	dataVersion, dataLines := readUCDFile(dataPath)
+
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_ucd.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintf(&buffer, "// builtinEmojiPropertiesVersion is the emoji version of\n")
+	fmt.Fprintf(&buffer, "// builtinEmojiProperties.\n")
+	fmt.Fprintf(&buffer, "const builtinEmojiPropertiesVersion = %q\n\n", dataVersion)
+	fmt.Fprintf(&buffer, "// builtinEmojiProperties is used when no emoji/emoji-data.txt is found in\n")
+	fmt.Fprintf(&buffer, "// ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinEmojiProperties = []ucdRange{\n")
+	for _, fields := range dataLines {
+		lo, hi, isRange := strings.Cut(fields[0], "..")
+		if !isRange {
+			hi = lo
+		}
+		fmt.Fprintf(&buffer, "\t{0x%s, 0x%s, %q},\n", lo, hi, fields[1])
+	}
+	fmt.Fprintf(&buffer, "}\n\n")
+
+	// single codepoints and ranges are covered by emoji-data.txt, as in
+	// loadEmojiSequences
+	fmt.Fprintf(&buffer, "// builtinEmojiSequences is used when no emoji/emoji-sequences.txt and\n")
+	fmt.Fprintf(&buffer, "// emoji/emoji-zwj-sequences.txt are found in ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinEmojiSequences = map[string]emojiSequenceType{\n")
+	for _, sequencesPath := range sequencesPaths {
+		_, sequenceLines := readUCDFile(sequencesPath)
+		for _, fields := range sequenceLines {
+			if !strings.Contains(fields[0], " ") {
+				continue
+			}
+			var sequence strings.Builder
+			for _, field := range strings.Fields(fields[0]) {
+				var codepoint rune
+				fmt.Sscanf(field, "%X", &codepoint)
+				sequence.WriteRune(codepoint)
+			}
+			fmt.Fprintf(&buffer, "\t%+q: {%q, %q},\n", sequence.String(), fields[1], fields[2])
+		}
+	}
+	fmt.Fprintf(&buffer, "}\n\n")
+
+	f, err := os.Open(testPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	// emoji-test.txt keeps its groups in comments, so it is read line by
+	// line instead of through readUCDFile
+	var testVersion string
+	var groups bytes.Buffer
+	openGroup, openSubgroup := false, false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case emojiVersionPattern.MatchString(line) && testVersion == "":
+			testVersion = emojiVersionPattern.FindStringSubmatch(line)[1]
+		case strings.HasPrefix(line, "# group: "):
+			if openSubgroup {
+				fmt.Fprintf(&groups, "}},\n")
+			}
+			if openGroup {
+				fmt.Fprintf(&groups, "}},\n")
+			}
+			fmt.Fprintf(&groups, "{%q, []emojiSubgroup{\n", strings.TrimPrefix(line, "# group: "))
+			openGroup, openSubgroup = true, false
+		case strings.HasPrefix(line, "# subgroup: "):
+			if openSubgroup {
+				fmt.Fprintf(&groups, "}},\n")
+			}
+			fmt.Fprintf(&groups, "{%q, []emojiEntry{\n", strings.TrimPrefix(line, "# subgroup: "))
+			openSubgroup = true
+		default:
+			match := emojiTestPattern.FindStringSubmatch(line)
+			if match == nil {
+				continue
+			}
+			var sequence strings.Builder
+			for _, field := range strings.Fields(match[1]) {
+				var codepoint rune
+				fmt.Sscanf(field, "%X", &codepoint)
+				sequence.WriteRune(codepoint)
+			}
+			fmt.Fprintf(&groups, "{%+q, %q, %q, %q},\n", sequence.String(), match[2], match[3], match[4])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	fileVersions[testPath] = testVersion
+	separateVersions[testPath] = true
+	if openSubgroup {
+		fmt.Fprintf(&groups, "}},\n")
+	}
+	if openGroup {
+		fmt.Fprintf(&groups, "}},\n")
+	}
+
+	fmt.Fprintf(&buffer, "// builtinEmojiTestVersion is the emoji version of builtinEmojiGroups.\n")
+	fmt.Fprintf(&buffer, "const builtinEmojiTestVersion = %q\n\n", testVersion)
+	fmt.Fprintf(&buffer, "// builtinEmojiGroups is used when no emoji/emoji-test.txt is found in\n")
+	fmt.Fprintf(&buffer, "// ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinEmojiGroups = []emojiGroup{\n")
+	buffer.Write(groups.Bytes())
+	fmt.Fprintf(&buffer, "}\n")
+
+	writeSource(outputPath, &buffer)
+}
+
+// generateBreaks writes the Grapheme_Cluster_Break and Word_Break values
+// and the Indic_Conjunct_Break values of DerivedCoreProperties.txt, the
+// properties the boundaries of UAX #29 are found with.
+func generateBreaks(graphemePath string, wordPath string, derivedCorePath string, outputPath string) {
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_ucd.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+
+	tables := []struct {
+		name, path, file, missing string
+	}{
+		{"GraphemeBreak", graphemePath, "auxiliary/GraphemeBreakProperty.txt", "Other"},
+		{"WordBreak", wordPath, "auxiliary/WordBreakProperty.txt", "Other"},
+		{"IndicConjunctBreak", derivedCorePath, "DerivedCoreProperties.txt", "None"},
+	}
+	for _, table := range tables {
+		version, lines := readUCDFile(table.path)
+		if table.name == "IndicConjunctBreak" {
+			var values [][]string
+			for _, fields := range lines {
+				if fields[1] == "InCB" {
+					values = append(values, []string{fields[0], fields[2]})
+				}
+			}
+			lines = values
+		}
+		sortUCDLines(lines)
+
+		fmt.Fprintf(&buffer, "// builtin%sVersion is the Unicode version of builtin%s.\n", table.name, table.name)
+		fmt.Fprintf(&buffer, "const builtin%sVersion = %q\n\n", table.name, version)
+		fmt.Fprintf(&buffer, "// builtin%s is used when no %s\n", table.name, table.file)
+		fmt.Fprintf(&buffer, "// is found in ucdDirectory. Codepoints it leaves out are %s.\n", table.missing)
+		fmt.Fprintf(&buffer, "var builtin%s = []ucdRange{\n", table.name)
+		for _, fields := range lines {
+			lo, hi, isRange := strings.Cut(fields[0], "..")
+			if !isRange {
+				hi = lo
+			}
+			fmt.Fprintf(&buffer, "\t{0x%s, 0x%s, %q},\n", lo, hi, fields[1])
+		}
+		fmt.Fprintf(&buffer, "}\n\n")
+	}
+
+	writeSource(outputPath, &buffer)
+}
+
+// sortUCDLines orders lines by their first codepoint, the files that group
+// their lines by value are looked up by binary search.
+func sortUCDLines(lines [][]string) {
+	first := func(fields []string) uint64 {
+		lo, _, _ := strings.Cut(fields[0], "..")
+		codepoint, _ := strconv.ParseUint(lo, 16, 32)
+		return codepoint
+	}
+	sort.SliceStable(lines, func(i, j int) bool { return first(lines[i]) < first(lines[j]) })
+}
+
+func generateAges(agePath string, outputPath string) {
+	version, lines := readUCDFile(agePath)
+
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_ucd.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintf(&buffer, "// builtinAgesVersion is the Unicode version of builtinAges.\n")
+	fmt.Fprintf(&buffer, "const builtinAgesVersion = %q\n\n", version)
+	fmt.Fprintf(&buffer, "// builtinAges is used when no DerivedAge.txt is found in ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinAges = []ucdRange{\n")
+	for _, fields := range lines {
+		lo, hi, isRange := strings.Cut(fields[0], "..")
+		if !isRange {
+			hi = lo
+		}
+		fmt.Fprintf(&buffer, "\t{0x%s, 0x%s, %q},\n", lo, hi, fields[1])
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	writeSource(outputPath, &buffer)
+}
+
+func generateScriptExtensions(scriptExtensionsPath string, outputPath string) {
+	version, lines := readUCDFile(scriptExtensionsPath)
+
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_ucd.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintf(&buffer, "// builtinScriptExtensionsVersion is the Unicode version of\n")
+	fmt.Fprintf(&buffer, "// builtinScriptExtensions.\n")
+	fmt.Fprintf(&buffer, "const builtinScriptExtensionsVersion = %q\n\n", version)
+	fmt.Fprintf(&buffer, "// builtinScriptExtensions is used when no ScriptExtensions.txt is found in\n")
+	fmt.Fprintf(&buffer, "// ucdDirectory. Values are space separated short script names.\n")
+	fmt.Fprintf(&buffer, "var builtinScriptExtensions = []ucdRange{\n")
+	for _, fields := range lines {
+		lo, hi, isRange := strings.Cut(fields[0], "..")
+		if !isRange {
+			hi = lo
+		}
+		fmt.Fprintf(&buffer, "\t{0x%s, 0x%s, %q},\n", lo, hi, fields[1])
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	writeSource(outputPath, &buffer)
+}
+
+func generateNames(aliasesPath string, sequencesPath string, outputPath string) {
+	version, aliasLines := readUCDFile(aliasesPath)
+	sequencesVersion, sequenceLines := readUCDFile(sequencesPath)
+	separateVersions[sequencesPath] = true
+
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_ucd.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintf(&buffer, "// builtinNamesVersion is the Unicode version of builtinNameAliases.\n")
+	fmt.Fprintf(&buffer, "const builtinNamesVersion = %q\n\n", version)
+	fmt.Fprintf(&buffer, "// builtinNamedSequencesVersion is the Unicode version of\n")
+	fmt.Fprintf(&buffer, "// builtinNamedSequences.\n")
+	fmt.Fprintf(&buffer, "const builtinNamedSequencesVersion = %q\n\n", sequencesVersion)
+	fmt.Fprintf(&buffer, "// builtinNameAliases is used when no NameAliases.txt is found in\n")
+	fmt.Fprintf(&buffer, "// ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinNameAliases = []nameAlias{\n")
+	for _, fields := range aliasLines {
+		fmt.Fprintf(&buffer, "\t{0x%s, %q, %q},\n", fields[0], fields[1], fields[2])
+	}
+	fmt.Fprintf(&buffer, "}\n\n")
+	fmt.Fprintf(&buffer, "// builtinNamedSequences is used when no NamedSequences.txt is found in\n")
+	fmt.Fprintf(&buffer, "// ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinNamedSequences = []namedSequence{\n")
+	for _, fields := range sequenceLines {
+		var sequence strings.Builder
+		for _, field := range strings.Fields(fields[1]) {
+			var codepoint rune
+			fmt.Sscanf(field, "%X", &codepoint)
+			sequence.WriteRune(codepoint)
+		}
+		fmt.Fprintf(&buffer, "\t{%q, %+q},\n", fields[0], sequence.String())
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	writeSource(outputPath, &buffer)
+}
+
+// generateUnicodeData writes the names, the names of the ranges UnicodeData.txt
+// lists by their first and last codepoint, the General_Category of every
+// assigned codepoint and the simple case mappings.
+func generateUnicodeData(unicodeDataPath string, outputPath string) {
+	_, lines := readUCDFile(unicodeDataPath)
+
+	var names, nameRanges, categories, caseMappings bytes.Buffer
+	var rangeStart string
+	var lastCategory struct{ lo, hi, value string }
+	flushCategory := func() {
+		if lastCategory.value != "" {
+			fmt.Fprintf(&categories, "\t{0x%s, 0x%s, %q},\n", lastCategory.lo, lastCategory.hi, lastCategory.value)
+		}
+	}
+	for _, fields := range lines {
+		codepoint, name, category := fields[0], fields[1], fields[2]
+		lo := codepoint
+		switch {
+		case strings.HasSuffix(name, ", First>"):
+			rangeStart = codepoint
+			continue
+		case strings.HasSuffix(name, ", Last>"):
+			lo = rangeStart
+			label := strings.TrimSuffix(strings.TrimPrefix(name, "<"), ", Last>")
+			fmt.Fprintf(&nameRanges, "\t{0x%s, 0x%s, %q},\n", lo, codepoint, label)
+		default:
+			fmt.Fprintf(&names, "\t{0x%s, 0x%s, %q},\n", codepoint, codepoint, name)
+		}
+
+		// an empty mapping maps to the codepoint itself, an empty titlecase
+		// mapping to the uppercase one
+		if upper, lower, title := fields[12], fields[13], fields[14]; upper != "" || lower != "" || title != "" {
+			if upper == "" {
+				upper = codepoint
+			}
+			if lower == "" {
+				lower = codepoint
+			}
+			if title == "" {
+				title = upper
+			}
+			fmt.Fprintf(&caseMappings, "\t0x%s: {0x%s, 0x%s, 0x%s},\n", codepoint, upper, lower, title)
+		}
+
+		var previous, next uint32
+		fmt.Sscanf(lastCategory.hi, "%X", &previous)
+		fmt.Sscanf(lo, "%X", &next)
+		if lastCategory.value == category && previous+1 == next {
+			lastCategory.hi = codepoint
+			continue
+		}
+		flushCategory()
+		lastCategory.lo, lastCategory.hi, lastCategory.value = lo, codepoint, category
+	}
+	flushCategory()
+
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_ucd.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintf(&buffer, "// builtinNames holds the name of every codepoint UnicodeData.txt names on\n")
+	fmt.Fprintf(&buffer, "// its own, sorted, and is used when no UnicodeData.txt is found in\n")
+	fmt.Fprintf(&buffer, "// ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinNames = []ucdRange{\n")
+	buffer.Write(names.Bytes())
+	fmt.Fprintf(&buffer, "}\n\n")
+	fmt.Fprintf(&buffer, "// builtinNameRanges holds the ideograph, Hangul syllable, surrogate and\n")
+	fmt.Fprintf(&buffer, "// private use ranges, whose names are derived.\n")
+	fmt.Fprintf(&buffer, "var builtinNameRanges = []ucdRange{\n")
+	buffer.Write(nameRanges.Bytes())
+	fmt.Fprintf(&buffer, "}\n\n")
+	fmt.Fprintf(&buffer, "// builtinCategories holds the two letter General_Category of every assigned\n")
+	fmt.Fprintf(&buffer, "// codepoint and is used when no UnicodeData.txt is found in ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinCategories = []ucdRange{\n")
+	buffer.Write(categories.Bytes())
+	fmt.Fprintf(&buffer, "}\n\n")
+	fmt.Fprintf(&buffer, "// builtinCaseMappings holds the simple case mappings of every codepoint\n")
+	fmt.Fprintf(&buffer, "// that has one and is used when no UnicodeData.txt is found in\n")
+	fmt.Fprintf(&buffer, "// ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinCaseMappings = map[rune]caseMapping{\n")
+	buffer.Write(caseMappings.Bytes())
+	fmt.Fprintf(&buffer, "}\n")
+
+	writeSource(outputPath, &buffer)
+}
+
+// generateRangeList writes a "codepoints; Value" file as builtin<name>.
+func generateRangeList(inputPath string, outputPath string, name string, description string) {
+	version, lines := readUCDFile(inputPath)
+	sortUCDLines(lines)
+
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_ucd.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintf(&buffer, "// builtin%sVersion is the Unicode version of builtin%s.\n", name, name)
+	fmt.Fprintf(&buffer, "const builtin%sVersion = %q\n\n", name, version)
+	fmt.Fprintf(&buffer, "// builtin%s %s\n", name, description)
+	fmt.Fprintf(&buffer, "var builtin%s = []ucdRange{\n", name)
+	for _, fields := range lines {
+		lo, hi, isRange := strings.Cut(fields[0], "..")
+		if !isRange {
+			hi = lo
+		}
+		fmt.Fprintf(&buffer, "\t{0x%s, 0x%s, %q},\n", lo, hi, fields[1])
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	writeSource(outputPath, &buffer)
+}
+
+// generateConfusables writes the prototype of every source character of
+// confusables.txt.
+func generateConfusables(confusablesPath string, outputPath string) {
+	_, lines := readUCDFile(confusablesPath)
+
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_ucd.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintf(&buffer, "// builtinConfusables maps the source characters of confusables.txt to\n")
+	fmt.Fprintf(&buffer, "// their prototypes and is used when no confusables.txt is found in\n")
+	fmt.Fprintf(&buffer, "// ucdDirectory.\n")
+	fmt.Fprintf(&buffer, "var builtinConfusables = map[rune]string{\n")
+	for _, fields := range lines {
+		var prototype strings.Builder
+		for _, field := range strings.Fields(fields[1]) {
+			var codepoint rune
+			fmt.Sscanf(field, "%X", &codepoint)
+			prototype.WriteRune(codepoint)
+		}
+		fmt.Fprintf(&buffer, "\t0x%s: %+q,\n", fields[0], prototype.String())
+	}
+	fmt.Fprintf(&buffer, "}\n")
+
+	writeSource(outputPath, &buffer)
+}
+
+// generateVersion checks that every file read comes from the same Unicode
+// release, emoji files naming it without the update version, and writes it
+// as builtinUnicodeVersion. The release may not be older than the one the
+// Go toolchain was built with.
+func generateVersion(outputPath string) {
+	var paths []string
+	for path := range fileVersions {
+		if !separateVersions[path] {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var version string
+	for _, path := range paths {
+		if strings.Count(fileVersions[path], ".") == 2 {
+			version = fileVersions[path]
+			break
+		}
+	}
+	for _, path := range paths {
+		fileVersion := fileVersions[path]
+		if fileVersion != "" && fileVersion != version && !strings.HasPrefix(version, fileVersion+".") {
+			log.Fatalf("%s is version %s, not Unicode %s", path, fileVersion, version)
+		}
+	}
+	if compareVersions(version, unicode.Version) < 0 {
+		log.Fatalf("Unicode %s is older than the Unicode %s of the Go toolchain", version, unicode.Version)
+	}
+
+	var buffer bytes.Buffer
+	fmt.Fprintln(&buffer, "// Code generated by gen_ucd.go; DO NOT EDIT.")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintln(&buffer, "package main")
+	fmt.Fprintln(&buffer)
+	fmt.Fprintf(&buffer, "// builtinUnicodeVersion is the Unicode release every built-in table is\n")
+	fmt.Fprintf(&buffer, "// generated from.\n")
+	fmt.Fprintf(&buffer, "const builtinUnicodeVersion = %q\n", version)
+
+	writeSource(outputPath, &buffer)
+}
+
+// compareVersions orders dotted version numbers numerically.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			return x - y
+		}
+	}
+	return 0
+}
@@ -4,5 +4,9 @@ go 1.19
 
 require (
 	github.com/julienschmidt/httprouter v1.3.0
+	github.com/rivo/uniseg v0.4.7
+	golang.org/x/crypto v0.8.0
 	golang.org/x/text v0.9.0
 )
+
+require golang.org/x/net v0.9.0 // indirect
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"net/http"
+	"sync/atomic"
+)
+
+// ready is set once the search and name indexes are built, and unset again
+// when the server starts shutting down.
+var ready atomic.Bool
+
+// withHealthChecks answers /healthz and /readyz on any listener before
+// handing other requests to handler. They are not logged, as they are polled.
+func withHealthChecks(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/healthz":
+			serveHealthCheck(writer, http.StatusOK, "ok")
+		case "/readyz":
+			if ready.Load() {
+				serveHealthCheck(writer, http.StatusOK, "ready")
+			} else {
+				serveHealthCheck(writer, http.StatusServiceUnavailable, "not ready")
+			}
+		default:
+			handler.ServeHTTP(writer, request)
+		}
+	})
+}
+
+func serveHealthCheck(writer http.ResponseWriter, status int, message string) {
+	writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	writer.Header().Set("Cache-Control", "no-store")
+	writer.WriteHeader(status)
+	writer.Write([]byte(message + "\n"))
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// maxInspectLength caps the number of bytes the string tools will look at.
+const maxInspectLength = 4096
+
+// InspectedCodepoint is one codepoint of an inspected string. Bytes that are
+// not valid UTF-8 show up as their own entry with Valid set to false.
+type InspectedCodepoint struct {
+	Offset    int    `json:"offset"`
+	Codepoint string `json:"codepoint"`
+	Character string `json:"character"`
+	Name      string `json:"name"`
+	Category  string `json:"category"`
+	Script    string `json:"script"`
+	UTF8      string `json:"utf8"`
+	UTF16     string `json:"utf16"`
+	Valid     bool   `json:"valid"`
+}
+
+// InspectedGrapheme is a user-perceived character, made of one or more
+// codepoints.
+type InspectedGrapheme struct {
+	Text       string               `json:"text"`
+	Codepoints []InspectedCodepoint `json:"codepoints"`
+}
+
+// InspectedString is the breakdown of a string shown by /inspect.
+type InspectedString struct {
+	Input          string              `json:"input"`
+	ByteCount      int                 `json:"bytes"`
+	CodepointCount int                 `json:"codepoints"`
+	UTF16Count     int                 `json:"utf16_units"`
+	GraphemeCount  int                 `json:"graphemes"`
+	UTF8           string              `json:"utf8"`
+	UTF16          string              `json:"utf16"`
+	Graphemes      []InspectedGrapheme `json:"grapheme_clusters"`
+}
+
+// getStringInput reads the "s" parameter of the string tools.
+func getStringInput(request *http.Request) (string, error) {
+	input := request.URL.Query().Get("s")
+	if len(input) > maxInspectLength {
+		return "", &inputError{errInputTooLong, fmt.Sprintf("%d bytes", len(input)), nil}
+	}
+	return input, nil
+}
+
+func formatUTF8(text string) string {
+	return fmt.Sprintf("% X", []byte(text))
+}
+
+func formatUTF16(units []uint16) string {
+	hex := make([]string, len(units))
+	for i, unit := range units {
+		hex[i] = fmt.Sprintf("%04X", unit)
+	}
+	return strings.Join(hex, " ")
+}
+
+func inspectCodepoint(offset int, codepoint rune, raw string) InspectedCodepoint {
+	inspected := InspectedCodepoint{
+		Offset: offset,
+		UTF8:   formatUTF8(raw),
+		Valid:  codepoint != utf8.RuneError || len(raw) > 1,
+	}
+	if !inspected.Valid {
+		inspected.Name = "invalid UTF-8"
+		return inspected
+	}
+
+	inspected.Codepoint = fmt.Sprintf("%U", codepoint)
+	inspected.Character = string(codepoint)
+	inspected.Name = runeName(codepoint)
+	inspected.Category = getGeneralCategory(codepoint)
+	inspected.Script = strings.Join(getScripts(codepoint), ", ")
+	inspected.UTF16 = formatUTF16(utf16.Encode([]rune{codepoint}))
+	return inspected
+}
+
+func inspectString(input string) InspectedString {
+	inspected := InspectedString{
+		Input:          input,
+		ByteCount:      len(input),
+		CodepointCount: utf8.RuneCountInString(input),
+		UTF8:           formatUTF8(input),
+		Graphemes:      []InspectedGrapheme{},
+	}
+
+	utf16Units := utf16.Encode([]rune(input))
+	inspected.UTF16Count = len(utf16Units)
+	inspected.UTF16 = formatUTF16(utf16Units)
+
+	offset := 0
+	state := -1
+	rest := input
+	for len(rest) > 0 {
+		var cluster string
+		cluster, rest, _, state = uniseg.FirstGraphemeClusterInString(rest, state)
+
+		grapheme := InspectedGrapheme{Text: cluster}
+		for i := 0; i < len(cluster); {
+			codepoint, size := utf8.DecodeRuneInString(cluster[i:])
+			grapheme.Codepoints = append(grapheme.Codepoints, inspectCodepoint(offset+i, codepoint, cluster[i:i+size]))
+			i += size
+		}
+		inspected.Graphemes = append(inspected.Graphemes, grapheme)
+		offset += len(cluster)
+	}
+	inspected.GraphemeCount = len(inspected.Graphemes)
+
+	return inspected
+}
+
+func serveInspectJSON(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	input, err := getStringInput(request)
+	if err != nil {
+		serveJSONError(writer, request, err, timer)
+		return
+	}
+
+	writer = setJSONHeaders(writer)
+	serveJSON(writer, request, inspectString(input), timer)
+}
+
+func serveInspect(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	writer.Header().Add("Vary", "Accept")
+	if wantsJSON(request) {
+		serveInspectJSON(writer, request, timer)
+		return
+	}
+
+	input, err := getStringInput(request)
+	if err != nil {
+		serveError(writer, request, err, timer)
+		return
+	}
+
+	writer = setHeaders(writer)
+
+	data := struct {
+		InspectedString
+		UnicodeVersion string
+	}{
+		InspectedString: inspectString(input),
+		UnicodeVersion:  unicodeVersion(),
+	}
+
+	templateFiles := []string{
+		"./template/base.template.html",
+		"./template/inspect.template.html",
+	}
+
+	serveFilesFromTemplate(writer, request, templateFiles, data, timer)
+}
@@ -1,12 +1,19 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"log"
 	"math/rand"
 	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/julienschmidt/httprouter"
@@ -15,25 +22,158 @@ import (
 var ucVersion = "0.2.1"
 
 func init() {
-	f, err := os.OpenFile("./log/"+fmt.Sprint(time.Now())+".log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	rand.Seed(time.Now().UnixNano())
+}
+
+// openLog logs to a new file in directory as well as stdout. Without a log
+// directory, i.e. in a container, stdout is all there is.
+func openLog(directory string) {
+	log.SetOutput(os.Stdout)
+	if directory == "" {
+		return
+	}
+	f, err := os.OpenFile(filepath.Join(directory, fmt.Sprint(time.Now())+".log"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
 	if err != nil {
-		log.Fatal(err)
+		log.Printf("log: %v, logging to stdout only", err)
+		return
 	}
 	log.SetOutput(io.MultiWriter(f, os.Stdout))
-	rand.Seed(int64(f.Fd()))
 }
 
 func main() {
+	loaded, err := loadConfig(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatalf("config: %v", err)
+	}
+	config = loaded
+	openLog(config.LogDirectory)
+
+	ucdDirectory = config.UCD
+	templateReload = config.Dev
+	if config.Assets != "" {
+		useAssetDirectory(config.Assets)
+	} else if config.Dev {
+		useAssetDirectory(".")
+	}
+
+	database, err := loadUCD(ucdDirectory)
+	if err != nil {
+		log.Printf("ucd: %v, using built-in Unicode %s tables", err, builtinUnicodeVersion)
+	} else {
+		ucd = database
+		log.Printf("ucd: loaded Unicode %s from %s", unicodeVersion(), ucdDirectory)
+	}
+
+	if err := templates.precompile(); err != nil {
+		log.Fatalf("templates: %v", err)
+	}
+
+	go func() {
+		var indexes sync.WaitGroup
+		indexes.Add(2)
+		go func() { getSearchIndex(); indexes.Done() }()
+		go func() { getNameIndex(); indexes.Done() }()
+		indexes.Wait()
+		ready.Store(true)
+		log.Print("ready")
+	}()
+
 	router := httprouter.New()
 	router.GET("/*filepath", serveUnicodeClick)
 
-	fmt.Println("unicode.click listening on 443")
+	var servers []*http.Server
+	if config.PlainHTTP {
+		fmt.Printf("unicode.click listening on %s as %s\n", config.HTTPAddress, config.BaseURL)
+		servers = append(servers, newServer(config.HTTPAddress, router))
+	} else {
+		tlsConfig, redirect, err := getTLSConfig()
+		if err != nil {
+			log.Fatalf("tls: %v", err)
+		}
 
-	go func() {
-		if err := http.ListenAndServe(":80", http.HandlerFunc(redirectToTLS)); err != nil {
-			log.Fatalf("ListenAndServe error: %v", err)
+		fmt.Printf("unicode.click listening on %s as %s\n", config.HTTPSAddress, config.BaseURL)
+
+		if config.HTTPAddress != "" {
+			servers = append(servers, newServer(config.HTTPAddress, redirect))
 		}
-	}()
+		server := newServer(config.HTTPSAddress, router)
+		server.TLSConfig = tlsConfig
+		servers = append(servers, server)
+	}
+
+	if err := serve(servers); err != nil {
+		log.Fatalf("ListenAndServe error: %v", err)
+	}
+}
+
+// Timeouts of every listener. Pages are rendered in well under a second, so
+// these only cut off clients that are slow or gone.
+const (
+	readHeaderTimeout = 10 * time.Second
+	readTimeout       = 30 * time.Second
+	writeTimeout      = 60 * time.Second
+	idleTimeout       = 120 * time.Second
+
+	// shutdownTimeout is how long requests in flight get to finish.
+	shutdownTimeout = 30 * time.Second
+)
 
-	log.Fatal(http.ListenAndServeTLS(":443", "./ssl/domain.cert.pem", "./ssl/private.key.pem", router))
+func newServer(address string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              address,
+		Handler:           withHealthChecks(handler),
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       readTimeout,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       idleTimeout,
+	}
+}
+
+// serve runs servers until one of them fails or SIGTERM or SIGINT arrives,
+// then shuts all of them down gracefully. It returns the error of the server
+// that failed.
+func serve(servers []*http.Server) (err error) {
+	stop, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer cancel()
+
+	failures := make(chan error, len(servers))
+	for _, server := range servers {
+		go func(server *http.Server) {
+			var err error
+			if server.TLSConfig != nil {
+				err = server.ListenAndServeTLS("", "")
+			} else {
+				err = server.ListenAndServe()
+			}
+			if !errors.Is(err, http.ErrServerClosed) {
+				failures <- fmt.Errorf("%s: %w", server.Addr, err)
+			}
+		}(server)
+	}
+
+	select {
+	case err = <-failures:
+	case <-stop.Done():
+		log.Print("shutting down")
+	}
+	ready.Store(false)
+
+	ctx, cancelShutdown := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancelShutdown()
+	var shutdowns sync.WaitGroup
+	for _, server := range servers {
+		shutdowns.Add(1)
+		go func(server *http.Server) {
+			defer shutdowns.Done()
+			if err := server.Shutdown(ctx); err != nil {
+				log.Printf("shutdown %s: %v", server.Addr, err)
+			}
+		}(server)
+	}
+	shutdowns.Wait()
+	log.Print("shut down")
+	return err
 }
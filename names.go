@@ -0,0 +1,258 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// nameAlias is one line of NameAliases.txt. kind is one of correction,
+// control, alternate, figment or abbreviation.
+type nameAlias struct {
+	codepoint rune
+	alias     string
+	kind      string
+}
+
+// namedSequence is one line of NamedSequences.txt.
+type namedSequence struct {
+	name     string
+	sequence string
+}
+
+// NameAlias is another name of a codepoint, see NameAliases.txt for what
+// the types mean.
+type NameAlias struct {
+	Alias string `json:"alias"`
+	Type  string `json:"type"`
+}
+
+// NamedSequence is a sequence of codepoints with a name of its own.
+// UnicodeVersion is the release of NamedSequences.txt it comes from, which
+// can be older than the rest of the data.
+type NamedSequence struct {
+	Name           string          `json:"name"`
+	Text           string          `json:"text"`
+	Codepoints     []CodepointLink `json:"codepoints"`
+	UnicodeVersion string          `json:"unicode_version"`
+}
+
+// NameLookup is what a name resolves to on /name pages. Type is "name" for
+// character names, "named sequence" or the type of an alias.
+type NameLookup struct {
+	Name       string          `json:"name"`
+	Type       string          `json:"type"`
+	Text       string          `json:"text"`
+	Codepoints []CodepointLink `json:"codepoints"`
+}
+
+func (database *ucdDatabase) loadNameAliases(path string) error {
+	version, err := parseUCDFile(path, func(fields []string) error {
+		if len(fields) < 3 {
+			return fmt.Errorf("expected 3 fields, got %d", len(fields))
+		}
+		codepoint, _, err := parseUCDCodepoints(fields[0])
+		if err != nil {
+			return err
+		}
+		database.nameAliases = append(database.nameAliases, nameAlias{codepoint, fields[1], fields[2]})
+		return nil
+	})
+	database.setVersion(version)
+	return err
+}
+
+func (database *ucdDatabase) loadNamedSequences(path string) error {
+	version, err := parseUCDFile(path, func(fields []string) error {
+		if len(fields) < 2 {
+			return fmt.Errorf("expected 2 fields, got %d", len(fields))
+		}
+		var sequence strings.Builder
+		for _, field := range strings.Fields(fields[1]) {
+			codepoint, _, err := parseUCDCodepoints(field)
+			if err != nil {
+				return err
+			}
+			sequence.WriteRune(codepoint)
+		}
+		database.namedSequences = append(database.namedSequences, namedSequence{fields[0], sequence.String()})
+		return nil
+	})
+	database.namedSequencesVersion = version
+	return err
+}
+
+func nameAliasList() []nameAlias {
+	if ucd != nil && ucd.nameAliases != nil {
+		return ucd.nameAliases
+	}
+	return builtinNameAliases
+}
+
+func namedSequenceList() (sequences []namedSequence, version string) {
+	if ucd != nil && ucd.namedSequences != nil {
+		return ucd.namedSequences, ucd.namedSequencesVersion
+	}
+	return builtinNamedSequences, builtinNamedSequencesVersion
+}
+
+func getNameAliases(codepoint rune) []NameAlias {
+	aliases := []NameAlias{}
+	for _, alias := range nameAliasList() {
+		if alias.codepoint == codepoint {
+			aliases = append(aliases, NameAlias{alias.alias, alias.kind})
+		}
+	}
+	return aliases
+}
+
+func getNamedSequence(sequence namedSequence, version string) NamedSequence {
+	return NamedSequence{
+		Name:           sequence.name,
+		Text:           sequence.sequence,
+		Codepoints:     getCodepointLinks(sequence.sequence),
+		UnicodeVersion: version,
+	}
+}
+
+// getNamedSequences returns the named sequences codepoint is part of.
+func getNamedSequences(codepoint rune) []NamedSequence {
+	sequences := []NamedSequence{}
+	list, version := namedSequenceList()
+	for _, sequence := range list {
+		if strings.ContainsRune(sequence.sequence, codepoint) {
+			sequences = append(sequences, getNamedSequence(sequence, version))
+		}
+	}
+	return sequences
+}
+
+// looseCharacterName applies the UAX #44 loose matching rule for character
+// names, UAX44-LM2: case, whitespace, underscores and medial hyphens, the
+// ones between two letters or digits, are ignored. The hyphen of U+1180
+// HANGUL JUNGSEONG O-E is kept, it would otherwise clash with U+116C HANGUL
+// JUNGSEONG OE, and so are the other hyphens, so U+0F68 TIBETAN LETTER A
+// is not U+0F60 TIBETAN LETTER -A.
+func looseCharacterName(name string) string {
+	name = strings.ToUpper(strings.Join(strings.Fields(strings.ReplaceAll(name, "_", " ")), " "))
+	if name == "HANGUL JUNGSEONG O-E" {
+		return strings.ReplaceAll(name, " ", "")
+	}
+
+	isAlphanumeric := func(b byte) bool {
+		return b >= 'A' && b <= 'Z' || b >= '0' && b <= '9'
+	}
+	var loose strings.Builder
+	for i := 0; i < len(name); i++ {
+		switch {
+		case name[i] == ' ':
+			continue
+		case name[i] == '-' && i > 0 && i < len(name)-1 && isAlphanumeric(name[i-1]) && isAlphanumeric(name[i+1]):
+			continue
+		}
+		loose.WriteByte(name[i])
+	}
+	return loose.String()
+}
+
+var (
+	nameIndex     map[string]NameLookup
+	nameIndexOnce sync.Once
+)
+
+// getNameIndex maps the loose form of every character name, alias and named
+// sequence to what it names. Character names win over aliases, and aliases
+// over named sequences.
+func getNameIndex() map[string]NameLookup {
+	nameIndexOnce.Do(func() {
+		timer := time.Now()
+		index := map[string]NameLookup{}
+		add := func(name, kind, text string) {
+			loose := looseCharacterName(name)
+			if _, ok := index[loose]; ok || loose == "" {
+				return
+			}
+			index[loose] = NameLookup{Name: name, Type: kind, Text: text}
+		}
+
+		assignedCodepoints(func(codepoint rune) {
+			// labels such as <control> are not names
+			if name := runeName(codepoint); !strings.HasPrefix(name, "<") {
+				add(name, "name", string(codepoint))
+			}
+		})
+		for _, alias := range nameAliasList() {
+			add(alias.alias, alias.kind, string(alias.codepoint))
+		}
+		sequences, _ := namedSequenceList()
+		for _, sequence := range sequences {
+			add(sequence.name, "named sequence", sequence.sequence)
+		}
+
+		nameIndex = index
+		log.Printf("names: indexed %d names in %s", len(index), time.Since(timer))
+	})
+	return nameIndex
+}
+
+// lookupName finds a character, alias or named sequence by name.
+func lookupName(name string) (NameLookup, bool) {
+	lookup, ok := getNameIndex()[looseCharacterName(name)]
+	if !ok {
+		return NameLookup{}, false
+	}
+	lookup.Codepoints = getCodepointLinks(lookup.Text)
+	return lookup, true
+}
+
+func getNameLookup(route string) (NameLookup, error) {
+	lookup, ok := lookupName(route)
+	if !ok {
+		return NameLookup{}, &inputError{errNotFound, route, []suggestion{
+			{"search character names", "/search?" + url.Values{"q": {route}}.Encode()},
+		}}
+	}
+	return lookup, nil
+}
+
+func serveNameJSON(writer http.ResponseWriter, request *http.Request, route string, timer time.Time) {
+	lookup, err := getNameLookup(route)
+	if err != nil {
+		serveJSONError(writer, request, err, timer)
+		return
+	}
+
+	writer = setJSONHeaders(writer)
+	serveJSON(writer, request, lookup, timer)
+}
+
+// serveName redirects a name to the page of what it names: a codepoint, an
+// emoji sequence, or /inspect for other named sequences.
+func serveName(writer http.ResponseWriter, request *http.Request, route string, timer time.Time) {
+	writer.Header().Add("Vary", "Accept")
+	if wantsJSON(request) {
+		serveNameJSON(writer, request, route, timer)
+		return
+	}
+
+	lookup, err := getNameLookup(route)
+	if err != nil {
+		serveError(writer, request, err, timer)
+		return
+	}
+
+	location := "/inspect?" + url.Values{"s": {lookup.Text}}.Encode()
+	if utf8.RuneCountInString(lookup.Text) == 1 {
+		location = "/cp/" + lookup.Codepoints[0].Codepoint
+	} else if _, ok := getEmojiIndex()[lookup.Text]; ok {
+		location = "/emoji/" + emojiSequencePath(lookup.Text)
+	}
+
+	http.Redirect(writer, request, location, http.StatusMovedPermanently)
+	logNow(request, timer)
+}
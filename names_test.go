@@ -0,0 +1,38 @@
+package main
+
+import "testing"
+
+func TestLookupNameLooseMatching(t *testing.T) {
+	for name, want := range map[string]rune{
+		"TIBETAN LETTER A":            0x0F68,
+		"TIBETAN LETTER -A":           0x0F60,
+		"tibetan_letter_-a":           0x0F60,
+		"TIBETAN SUBJOINED LETTER A":  0x0FB8,
+		"TIBETAN SUBJOINED LETTER -A": 0x0FB0,
+		"HANGUL JUNGSEONG OE":         0x116C,
+		"hangul jungseong o-e":        0x1180,
+		"latin small letter a":        0x0061,
+		"Latin-Small-Letter-A":        0x0061,
+		"ZERO WIDTH SPACE":            0x200B,
+		"zero-width space":            0x200B,
+	} {
+		lookup, ok := lookupName(name)
+		if !ok || len(lookup.Codepoints) != 1 || []rune(lookup.Text)[0] != want {
+			t.Errorf("lookupName(%q) = %+v, %t, want %U", name, lookup, ok, want)
+		}
+	}
+}
+
+func TestServeNameRoutes(t *testing.T) {
+	for target, want := range map[string]string{
+		"/name/TIBETAN%20LETTER%20A":            "/cp/U+0F68",
+		"/name/TIBETAN%20LETTER%20-A":           "/cp/U+0F60",
+		"/cp/TIBETAN%20SUBJOINED%20LETTER%20A":  "/cp/U+0FB8",
+		"/cp/TIBETAN%20SUBJOINED%20LETTER%20-A": "/cp/U+0FB0",
+	} {
+		recorder := serveTestRequest(target)
+		if location := recorder.Header().Get("Location"); location != want {
+			t.Errorf("%s: got status %d to %q, want %s", target, recorder.Code, location, want)
+		}
+	}
+}
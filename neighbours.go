@@ -0,0 +1,169 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"unicode"
+)
+
+// RowCell is one of the 16 codepoints of the row around a codepoint.
+type RowCell struct {
+	CodepointLink
+	Assigned bool `json:"assigned"`
+	Current  bool `json:"current"`
+}
+
+// Neighbours links the codepoints around a codepoint: the closest assigned
+// ones before and after it, the ones in the same column of the rows above
+// and below, and the row of 16 it sits in as laid out on range pages.
+type Neighbours struct {
+	Previous *CodepointLink `json:"previous,omitempty"`
+	Next     *CodepointLink `json:"next,omitempty"`
+	Above    *CodepointLink `json:"above,omitempty"`
+	Below    *CodepointLink `json:"below,omitempty"`
+
+	// Row is the name of the row on range pages, i.e. "004" for U+0040 to
+	// U+004F, and RowPath links it on its block page.
+	Row     string    `json:"row"`
+	RowPath string    `json:"-"`
+	Cells   []RowCell `json:"cells"`
+}
+
+var (
+	assignedRanges     []ucdRange
+	assignedRangesOnce sync.Once
+)
+
+// getAssignedRanges merges the General_Category tables into the sorted
+// ranges of codepoints that have a page, everything but Cn and Cs plus the
+// noncharacters, so neighbours are found by binary search instead of walking
+// up to the whole codespace one codepoint at a time.
+func getAssignedRanges() []ucdRange {
+	assignedRangesOnce.Do(func() {
+		var ranges []ucdRange
+		add := func(lo, hi, stride rune) {
+			if stride == 1 {
+				ranges = append(ranges, ucdRange{lo: lo, hi: hi})
+				return
+			}
+			for codepoint := lo; codepoint <= hi; codepoint += stride {
+				ranges = append(ranges, ucdRange{lo: codepoint, hi: codepoint})
+			}
+		}
+		tables := []*unicode.RangeTable{propertyTables()["Noncharacter_Code_Point"]}
+		for categoryName, categoryRangeTable := range categoryTables() {
+			switch categoryName {
+			case "Cn", "Cs", "LC":
+				continue
+			}
+			if len(categoryName) == 2 {
+				tables = append(tables, categoryRangeTable)
+			}
+		}
+		for _, table := range tables {
+			if table == nil {
+				continue
+			}
+			for _, r16 := range table.R16 {
+				add(rune(r16.Lo), rune(r16.Hi), rune(r16.Stride))
+			}
+			for _, r32 := range table.R32 {
+				add(rune(r32.Lo), rune(r32.Hi), rune(r32.Stride))
+			}
+		}
+		sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+		for _, r := range ranges {
+			if last := len(assignedRanges) - 1; last >= 0 && r.lo <= assignedRanges[last].hi+1 {
+				if r.hi > assignedRanges[last].hi {
+					assignedRanges[last].hi = r.hi
+				}
+				continue
+			}
+			assignedRanges = append(assignedRanges, r)
+		}
+	})
+	return assignedRanges
+}
+
+// hasPage reports whether codepoint can be shown on a codepoint page.
+func hasPage(codepoint rune) bool {
+	_, ok := findUCDRange(getAssignedRanges(), codepoint)
+	return ok
+}
+
+// previousAssigned returns the closest codepoint before codepoint that has a
+// page of its own.
+func previousAssigned(codepoint rune) (rune, bool) {
+	ranges := getAssignedRanges()
+	// the first range ending at or after codepoint, the one before it ends
+	// before codepoint
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].hi >= codepoint })
+	if i < len(ranges) && ranges[i].lo < codepoint {
+		return codepoint - 1, true
+	}
+	if i > 0 {
+		return ranges[i-1].hi, true
+	}
+	return 0, false
+}
+
+// nextAssigned returns the closest codepoint after codepoint that has a page
+// of its own.
+func nextAssigned(codepoint rune) (rune, bool) {
+	ranges := getAssignedRanges()
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].hi > codepoint })
+	if i == len(ranges) {
+		return 0, false
+	}
+	if ranges[i].lo > codepoint {
+		return ranges[i].lo, true
+	}
+	return codepoint + 1, true
+}
+
+func getCodepointLink(codepoint rune) *CodepointLink {
+	return &CodepointLink{fmt.Sprintf("%U", codepoint), string(codepoint)}
+}
+
+// rowName names the row of codepoint the way generateTableFromRTLiteral
+// does, its hexadecimal digits without the last one.
+func rowName(codepoint rune) string {
+	hex := fmt.Sprintf("%04X", codepoint)
+	return hex[:len(hex)-1]
+}
+
+func getNeighbours(codepoint rune) Neighbours {
+	neighbours := Neighbours{
+		Row:   rowName(codepoint),
+		Cells: []RowCell{},
+	}
+
+	if previous, ok := previousAssigned(codepoint); ok {
+		neighbours.Previous = getCodepointLink(previous)
+	}
+	if next, ok := nextAssigned(codepoint); ok {
+		neighbours.Next = getCodepointLink(next)
+	}
+	if hasPage(codepoint - 16) {
+		neighbours.Above = getCodepointLink(codepoint - 16)
+	}
+	if hasPage(codepoint + 16) {
+		neighbours.Below = getCodepointLink(codepoint + 16)
+	}
+
+	if block, ok := getBlock(codepoint); ok {
+		neighbours.RowPath = "/block/" + blockSlug(block.value) + "#row-" + neighbours.Row
+	}
+
+	rowStart := codepoint &^ 0xF
+	for cell := rowStart; cell < rowStart+16; cell++ {
+		neighbours.Cells = append(neighbours.Cells, RowCell{
+			CodepointLink: *getCodepointLink(cell),
+			Assigned:      hasPage(cell),
+			Current:       cell == codepoint,
+		})
+	}
+
+	return neighbours
+}
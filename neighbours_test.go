@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+	"unicode"
+)
+
+// slowHasPage is what hasPage computes, one category lookup at a time.
+func slowHasPage(codepoint rune) bool {
+	return validateCodepoint(codepoint, "") == nil
+}
+
+func TestAssignedNeighbours(t *testing.T) {
+	for _, codepoint := range []rune{0, 0x41, 0x377, 0x378, 0xD800, 0xE000, 0xFDD0, 0xFDEF, 0xFFFE, 0xFFFF, 0x10000, 0x2FFFF, 0x50000, 0xE0001, 0x10FFFD, unicode.MaxRune} {
+		if got, want := hasPage(codepoint), slowHasPage(codepoint); got != want {
+			t.Errorf("hasPage(%U) = %t, want %t", codepoint, got, want)
+		}
+
+		wantPrevious, wantPreviousOK := rune(0), false
+		for previous := codepoint - 1; previous >= 0; previous-- {
+			if slowHasPage(previous) {
+				wantPrevious, wantPreviousOK = previous, true
+				break
+			}
+		}
+		if previous, ok := previousAssigned(codepoint); previous != wantPrevious || ok != wantPreviousOK {
+			t.Errorf("previousAssigned(%U) = %U, %t, want %U, %t", codepoint, previous, ok, wantPrevious, wantPreviousOK)
+		}
+
+		wantNext, wantNextOK := rune(0), false
+		for next := codepoint + 1; next <= unicode.MaxRune; next++ {
+			if slowHasPage(next) {
+				wantNext, wantNextOK = next, true
+				break
+			}
+		}
+		if next, ok := nextAssigned(codepoint); next != wantNext || ok != wantNextOK {
+			t.Errorf("nextAssigned(%U) = %U, %t, want %U, %t", codepoint, next, ok, wantNext, wantNextOK)
+		}
+	}
+}
+
+// BenchmarkNeighbours looks around a codepoint in a plane with nothing
+// assigned, the worst case of a linear search.
+func BenchmarkNeighbours(b *testing.B) {
+	getAssignedRanges()
+	for i := 0; i < b.N; i++ {
+		getNeighbours(0x50000)
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// CodepointLink is a codepoint in a form that can be linked to its page.
+type CodepointLink struct {
+	Codepoint string `json:"codepoint"`
+	Character string `json:"character"`
+}
+
+// NormalizationForm is a string after applying one of the four Unicode
+// normalization forms.
+type NormalizationForm struct {
+	Form       string          `json:"form"`
+	Text       string          `json:"text"`
+	Codepoints []CodepointLink `json:"codepoints"`
+	Changed    bool            `json:"changed"`
+}
+
+// Normalization describes how a single codepoint behaves under UAX #15.
+type Normalization struct {
+	DecompositionType    string              `json:"decomposition_type"`
+	Decomposition        []CodepointLink     `json:"decomposition"`
+	CombiningClass       uint8               `json:"combining_class"`
+	CompositionExclusion bool                `json:"full_composition_exclusion"`
+	Forms                []NormalizationForm `json:"forms"`
+	UnicodeVersion       string              `json:"unicode_version"`
+}
+
+// NormalizedString is the result of the /normalize tool. UnicodeVersion is
+// the release of x/text's normalization tables, not the site's.
+type NormalizedString struct {
+	Input          string              `json:"input"`
+	Forms          []NormalizationForm `json:"forms"`
+	UnicodeVersion string              `json:"unicode_version"`
+}
+
+var normalizationForms = []struct {
+	name string
+	form norm.Form
+}{
+	{"NFC", norm.NFC},
+	{"NFD", norm.NFD},
+	{"NFKC", norm.NFKC},
+	{"NFKD", norm.NFKD},
+}
+
+func getCodepointLinks(text string) []CodepointLink {
+	links := []CodepointLink{}
+	for _, codepoint := range text {
+		links = append(links, CodepointLink{fmt.Sprintf("%U", codepoint), string(codepoint)})
+	}
+	return links
+}
+
+func getNormalizationForms(text string) []NormalizationForm {
+	var forms []NormalizationForm
+	for _, normalizationForm := range normalizationForms {
+		normalized := normalizationForm.form.String(text)
+		forms = append(forms, NormalizationForm{
+			Form:       normalizationForm.name,
+			Text:       normalized,
+			Codepoints: getCodepointLinks(normalized),
+			Changed:    normalized != text,
+		})
+	}
+	return forms
+}
+
+func getNormalization(codepoint rune) Normalization {
+	literal := string(codepoint)
+	canonical := norm.NFD.String(literal)
+	compatibility := norm.NFKD.String(literal)
+
+	normalization := Normalization{
+		DecompositionType: "none",
+		Decomposition:     []CodepointLink{},
+		CombiningClass:    norm.NFD.PropertiesString(literal).CCC(),
+		Forms:             getNormalizationForms(literal),
+		UnicodeVersion:    norm.Version,
+	}
+
+	// x/text only exposes the full decomposition, not the single level
+	// mapping of UnicodeData.txt
+	switch {
+	case canonical != literal:
+		normalization.DecompositionType = "canonical"
+		normalization.Decomposition = getCodepointLinks(canonical)
+		// a canonical decomposition that NFC does not undo is excluded from
+		// composition
+		normalization.CompositionExclusion = norm.NFC.String(literal) != literal
+	case compatibility != literal:
+		normalization.DecompositionType = "compatibility"
+		normalization.Decomposition = getCodepointLinks(compatibility)
+	}
+
+	return normalization
+}
+
+func serveNormalizeJSON(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	input, err := getStringInput(request)
+	if err != nil {
+		serveJSONError(writer, request, err, timer)
+		return
+	}
+
+	writer = setJSONHeaders(writer)
+	serveJSON(writer, request, NormalizedString{input, getNormalizationForms(input), norm.Version}, timer)
+}
+
+func serveNormalize(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	writer.Header().Add("Vary", "Accept")
+	if wantsJSON(request) {
+		serveNormalizeJSON(writer, request, timer)
+		return
+	}
+
+	input, err := getStringInput(request)
+	if err != nil {
+		serveError(writer, request, err, timer)
+		return
+	}
+
+	writer = setHeaders(writer)
+
+	data := struct {
+		NormalizedString
+		UnicodeVersion string
+	}{
+		NormalizedString: NormalizedString{input, getNormalizationForms(input), norm.Version},
+		UnicodeVersion:   unicodeVersion(),
+	}
+
+	templateFiles := []string{
+		"./template/base.template.html",
+		"./template/normalize.template.html",
+	}
+
+	serveFilesFromTemplate(writer, request, templateFiles, data, timer)
+}
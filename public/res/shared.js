@@ -22,4 +22,22 @@ window.onload = function () {
 	settingsButton.addEventListener("click", toggleModal)
 	let closeButton = document.getElementById("closebutton")
 	closeButton.addEventListener("click", toggleModal)
-};
\ No newline at end of file
+};
+
+// links with a data-keys attribute are followed when one of the keys it
+// lists is pressed, unless a form field has the focus
+document.addEventListener("keydown", function (event) {
+	if (event.altKey || event.ctrlKey || event.metaKey) {
+		return
+	}
+	let target = event.target
+	if (target.isContentEditable || ["INPUT", "TEXTAREA", "SELECT"].includes(target.tagName)) {
+		return
+	}
+
+	let link = document.querySelector('a[data-keys~="' + CSS.escape(event.key) + '"]')
+	if (link) {
+		event.preventDefault()
+		window.location.href = link.href
+	}
+})
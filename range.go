@@ -1,8 +1,10 @@
 package main
 
 import (
+	"encoding/csv"
 	"fmt"
 	"html/template"
+	"log"
 	"net/http"
 	"strconv"
 	"strings"
@@ -22,6 +24,9 @@ type row struct {
 
 type rangeData struct {
 	RangeTableName string
+	ExportPath     string
+	Path           string
+	WidthFilter    string
 	Tables         []table
 
 	UnicodeVersion string
@@ -51,17 +56,19 @@ type rangeData struct {
 func generateTableFromRTLiteral(rtLiteral *unicode.RangeTable) (tables []table, tableLengths []int) {
 	var rawRuneSlice []int32
 
+	// the bounds are widened to rune first, a uint16 loop counter would wrap
+	// around at 0xFFFF and never end
 	for i := 0; i < len(rtLiteral.R16); i++ {
-		rtLo := rtLiteral.R16[i].Lo
-		rtHi := rtLiteral.R16[i].Hi
-		for runeLiteral := rtLo; runeLiteral <= rtHi; runeLiteral += rtLiteral.R16[i].Stride {
-			rawRuneSlice = append(rawRuneSlice, int32(runeLiteral))
+		rtLo := rune(rtLiteral.R16[i].Lo)
+		rtHi := rune(rtLiteral.R16[i].Hi)
+		for runeLiteral := rtLo; runeLiteral <= rtHi; runeLiteral += rune(rtLiteral.R16[i].Stride) {
+			rawRuneSlice = append(rawRuneSlice, runeLiteral)
 		}
 	}
 	for i := 0; i < len(rtLiteral.R32); i++ {
-		rtLo := rtLiteral.R32[i].Lo
-		rtHi := rtLiteral.R32[i].Hi
-		for runeLiteral := rtLo; runeLiteral <= rtHi; runeLiteral += rtLiteral.R32[i].Stride {
+		rtLo := int64(rtLiteral.R32[i].Lo)
+		rtHi := int64(rtLiteral.R32[i].Hi)
+		for runeLiteral := rtLo; runeLiteral <= rtHi; runeLiteral += int64(rtLiteral.R32[i].Stride) {
 			rawRuneSlice = append(rawRuneSlice, int32(runeLiteral))
 		}
 	}
@@ -113,17 +120,174 @@ func generateTableFromRTLiteral(rtLiteral *unicode.RangeTable) (tables []table,
 	return
 }
 
+// RangeCodepoint is a single cell of a generated range table.
+type RangeCodepoint struct {
+	Codepoint string `json:"codepoint"`
+	Name      string `json:"name"`
+	Category  string `json:"category"`
+	Assigned  bool   `json:"assigned"`
+	InRange   bool   `json:"in_range"`
+}
+
+// RangeDocument is the machine-readable form of a /range page.
+type RangeDocument struct {
+	Name           string           `json:"name"`
+	UnicodeVersion string           `json:"unicode_version"`
+	Codepoints     []RangeCodepoint `json:"codepoints"`
+}
+
+func getRangeDocument(name string, rtLiteral *unicode.RangeTable) RangeDocument {
+	tables, _ := generateTableFromRTLiteral(rtLiteral)
+
+	codepoints := []RangeCodepoint{}
+	for _, table := range tables {
+		for _, row := range table.rows {
+			for _, codepoint := range row.row {
+				category := getGeneralCategory(codepoint)
+				codepoints = append(codepoints, RangeCodepoint{
+					Codepoint: fmt.Sprintf("%U", codepoint),
+					Name:      runeName(codepoint),
+					Category:  category,
+					Assigned:  category != "Cn",
+					InRange:   unicode.In(codepoint, rtLiteral),
+				})
+			}
+		}
+	}
+
+	return RangeDocument{
+		Name:           name,
+		UnicodeVersion: unicodeVersion(),
+		Codepoints:     codepoints,
+	}
+}
+
+func serveRangeJSON(writer http.ResponseWriter, request *http.Request, name string, rtLiteral *unicode.RangeTable, timer time.Time) {
+	writer = setJSONHeaders(writer)
+
+	serveJSON(writer, request, getRangeDocument(name, rtLiteral), timer)
+}
+
+// serveRangeDelimited writes a range as CSV or TSV depending on separator.
+func serveRangeDelimited(writer http.ResponseWriter, request *http.Request, name string, rtLiteral *unicode.RangeTable, separator rune, timer time.Time) {
+	contentType, extension := "text/csv", "csv"
+	if separator == '\t' {
+		contentType, extension = "text/tab-separated-values", "tsv"
+	}
+	writer.Header().Set("Content-Type", contentType+"; charset=utf-8")
+	writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", blockSlug(name)+"."+extension))
+	writer.Header().Set("Cache-Control", "public, max-age=3600")
+	writer.Header().Set("ETag", ucVersion)
+
+	document := getRangeDocument(name, rtLiteral)
+
+	csvWriter := csv.NewWriter(writer)
+	csvWriter.Comma = separator
+	csvWriter.Write([]string{"codepoint", "name", "category", "assigned", "in_range"})
+	for _, codepoint := range document.Codepoints {
+		csvWriter.Write([]string{
+			codepoint.Codepoint,
+			codepoint.Name,
+			codepoint.Category,
+			strconv.FormatBool(codepoint.Assigned),
+			strconv.FormatBool(codepoint.InRange),
+		})
+	}
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		log.Print(err.Error())
+		return
+	}
+
+	logNow(request, timer)
+}
+
+// rangeExportExtensions are the export formats of ranges.
+var rangeExportExtensions = []string{".json", ".csv", ".tsv"}
+
+// splitRangeExtension splits a known export extension off route. Anything
+// else stays part of the name, such as the ".1" of "/api/v1/age/6.1".
+func splitRangeExtension(route string) (name, extension string) {
+	for _, extension := range rangeExportExtensions {
+		if strings.HasSuffix(route, extension) {
+			return strings.TrimSuffix(route, extension), extension
+		}
+	}
+	return route, ""
+}
+
+// serveRangeExport picks the export format from the extension of route,
+// which is expected to be one of rangeExportExtensions or nothing.
+func serveRangeExport(writer http.ResponseWriter, request *http.Request, route string, timer time.Time, lookup func(string) (string, *unicode.RangeTable, bool)) {
+	route, extension := splitRangeExtension(route)
+	name, rtLiteral, ok := lookup(route)
+	if !ok {
+		serveJSONError(writer, request, &inputError{errUnknownRange, route, rangeSuggestions(route)}, timer)
+		return
+	}
+
+	switch extension {
+	case ".csv":
+		serveRangeDelimited(writer, request, name, rtLiteral, ',', timer)
+	case ".tsv":
+		serveRangeDelimited(writer, request, name, rtLiteral, '\t', timer)
+	default:
+		serveRangeJSON(writer, request, name, rtLiteral, timer)
+	}
+}
+
+func lookupRange(route string) (name string, rtLiteral *unicode.RangeTable, ok bool) {
+	entry, ok := getRangeRegistry().lookup(route)
+	if !ok {
+		return "", nil, false
+	}
+	return entry.Name, entry.Table, true
+}
+
+func lookupBlockRange(route string) (name string, rtLiteral *unicode.RangeTable, ok bool) {
+	block, ok := lookupBlock(route)
+	if !ok {
+		return "", nil, false
+	}
+	return block.value, getBlockRangeTable(block), true
+}
+
 func serveRange(writer http.ResponseWriter, request *http.Request, route string, timer time.Time) {
-	route = strings.ToLower(route)
+	name, rtLiteral, ok := lookupRange(route)
+	if !ok {
+		serveError(writer, request, &inputError{errUnknownRange, route, rangeSuggestions(route)}, timer)
+		return
+	}
+
+	serveRangeTable(writer, request, name, "/api/v1/range/"+name, rtLiteral, timer)
+}
+
+// serveRangeTable renders any range table, either as the HTML grid or, when
+// asked for through the Accept header, as JSON.
+func serveRangeTable(writer http.ResponseWriter, request *http.Request, name string, exportPath string, rtLiteral *unicode.RangeTable, timer time.Time) {
+	writer.Header().Add("Vary", "Accept")
+	if wantsJSON(request) {
+		serveRangeJSON(writer, request, name, rtLiteral, timer)
+		return
+	}
+
+	widthFilter, err := getWidthFilter(request)
+	if err != nil {
+		serveError(writer, request, err, timer)
+		return
+	}
+
 	writer = setHeaders(writer)
-	rtLiteral := getRangeTableLiteral(route)
 	tables, tableLengths := generateTableFromRTLiteral(rtLiteral)
 
 	data := rangeData{
-		RangeTableName: route,
+		RangeTableName: name,
+		ExportPath:     exportPath,
+		Path:           request.URL.Path,
+		WidthFilter:    request.URL.Query().Get("width"),
 		Tables:         tables,
 
-		UnicodeVersion: unicode.Version,
+		UnicodeVersion: unicodeVersion(),
 
 		NumberOfTables: len(tables),
 		TableLengths:   tableLengths,
@@ -131,7 +295,7 @@ func serveRange(writer http.ResponseWriter, request *http.Request, route string,
 		TableLiteral: "",
 	}
 
-	data.TableLiteral = generateTableHTML(data.Tables, data.TableLengths, rtLiteral)
+	data.TableLiteral = generateTableHTML(data.Tables, data.TableLengths, rtLiteral, widthFilter)
 
 	templateFiles := []string{
 		"./template/base.template.html",
@@ -141,7 +305,9 @@ func serveRange(writer http.ResponseWriter, request *http.Request, route string,
 	serveFilesFromTemplate(writer, request, templateFiles, data, timer)
 }
 
-func generateTableHTML(tables []table, tableLengths []int, literalRT *unicode.RangeTable) template.HTML {
+// generateTableHTML renders the grid of a range. Cells whose East_Asian_Width
+// is in widthFilter are highlighted.
+func generateTableHTML(tables []table, tableLengths []int, literalRT *unicode.RangeTable, widthFilter map[string]bool) template.HTML {
 	var literal []template.HTML
 
 	literal = append(literal, "<div id=tables>")
@@ -169,13 +335,24 @@ func generateTableHTML(tables []table, tableLengths []int, literalRT *unicode.Ra
         <th>F</th></tr>
 		`)
 		for row := 0; row < tableLengths[i]; row++ {
-			literal = append(literal, "<tr>")
+			// rows are anchored so codepoint pages can link to them
+			literal = append(literal, `<tr id="row-`, template.HTML(tables[i].rows[row].name), `">`)
 			literal = append(literal, "<td>U+", template.HTML(tables[i].rows[row].name), "</td>")
 			for j := 0; j < len(tables[i].rows[row].row); j++ {
-				if unicode.In(tables[i].rows[row].row[j], literalRT) {
-					literal = append(literal, `<td><a href="/cp/`, template.HTML(fmt.Sprintf("%U", tables[i].rows[row].row[j])), `">`, template.HTML(tables[i].rows[row].row[j]), "</a></td>")
-				} else {
-					literal = append(literal, `<td class="invalid"><a href="/cp/`, template.HTML(fmt.Sprintf("%U", tables[i].rows[row].row[j])), `">`, template.HTML(tables[i].rows[row].row[j]), "</a></td>")
+				codepoint := tables[i].rows[row].row[j]
+				link := template.HTML(fmt.Sprintf(`<a href="/cp/%U">%s</a>`, codepoint, template.HTMLEscapeString(string(codepoint))))
+				switch {
+				case !hasPage(codepoint):
+					// unassigned codepoints and surrogates have no page to link to
+					literal = append(literal, `<td class="invalid"></td>`)
+				case unicode.In(codepoint, literalRT):
+					cell := template.HTML("<td>")
+					if widthFilter[getEastAsianWidth(codepoint)] {
+						cell = `<td class="highlight">`
+					}
+					literal = append(literal, cell, link, "</td>")
+				default:
+					literal = append(literal, `<td class="invalid">`, link, "</td>")
 				}
 			}
 			literal = append(literal, "</tr>")
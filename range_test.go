@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+	"unicode"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// withTimeout fails t when f runs longer than timeout, so a loop that never
+// ends is reported instead of running until the process runs out of memory.
+func withTimeout(t *testing.T, timeout time.Duration, f func()) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		t.Fatalf("did not finish within %s", timeout)
+	}
+}
+
+func serveTestRequest(target string) *httptest.ResponseRecorder {
+	router := httprouter.New()
+	router.GET("/*filepath", serveUnicodeClick)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest("GET", target, nil))
+	return recorder
+}
+
+func TestGenerateTableEndingAtFFFF(t *testing.T) {
+	rangeTable := &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0xFFFD, Hi: 0xFFFF, Stride: 1}}}
+
+	withTimeout(t, 10*time.Second, func() {
+		tables, tableLengths := generateTableFromRTLiteral(rangeTable)
+		if len(tables) != 1 || len(tableLengths) != 1 || tableLengths[0] != 1 {
+			t.Errorf("got %d tables with lengths %v, want 1 table of 1 row", len(tables), tableLengths)
+			return
+		}
+		if row := tables[0].rows[0]; row.name != "FFF" || row.row[15] != 0xFFFF {
+			t.Errorf("got row %s ending at %U, want FFF ending at U+FFFF", row.name, row.row[15])
+		}
+	})
+}
+
+func TestServeAgeEndingAtFFFF(t *testing.T) {
+	// Unicode 1.1 ends with U+FFFD..U+FFFF
+	withTimeout(t, 10*time.Second, func() {
+		if recorder := serveTestRequest("/age/1.1"); recorder.Code != 200 {
+			t.Errorf("got status %d, want 200", recorder.Code)
+		}
+	})
+}
+
+func TestServeRangeExportVersions(t *testing.T) {
+	for target, want := range map[string]string{
+		"/api/v1/age/6.1":      "Unicode 6.1",
+		"/api/v1/age/6.1.json": "Unicode 6.1",
+		"/api/v1/age/1.1":      "Unicode 1.1",
+		"/api/v1/age/v6":       "Unicode 6.0",
+	} {
+		var document RangeDocument
+		recorder := serveTestRequest(target)
+		if err := json.NewDecoder(recorder.Body).Decode(&document); err != nil {
+			t.Errorf("%s: got status %d, %v", target, recorder.Code, err)
+			continue
+		}
+		if document.Name != want {
+			t.Errorf("%s: got %q, want %q", target, document.Name, want)
+		}
+	}
+
+	recorder := serveTestRequest("/api/v1/age/6.1.csv")
+	if !strings.HasPrefix(recorder.Header().Get("Content-Type"), "text/csv") {
+		t.Errorf("/api/v1/age/6.1.csv: got Content-Type %q, want text/csv", recorder.Header().Get("Content-Type"))
+	}
+}
+
+// TestRangeCellLinks follows every codepoint link of range pages holding
+// noncharacters and surrogates, which are not assigned but have a page or
+// are not linked.
+func TestRangeCellLinks(t *testing.T) {
+	cellLink := regexp.MustCompile(`href="(/cp/[^"]+)"`)
+	for _, target := range []string{"/range/noncharacter_code_point", "/age/3.1", "/range/cs"} {
+		recorder := serveTestRequest(target)
+		if recorder.Code != 200 {
+			t.Errorf("%s: got status %d, want 200", target, recorder.Code)
+			continue
+		}
+		failures := 0
+		for _, match := range cellLink.FindAllStringSubmatch(recorder.Body.String(), -1) {
+			if linked := serveTestRequest(match[1]); linked.Code != 200 && failures < 10 {
+				t.Errorf("%s links %s, which is status %d", target, match[1], linked.Code)
+				failures++
+			}
+		}
+	}
+
+	for _, target := range []string{"/cp/U+FDD0", "/cp/U+FFFF", "/cp/U+10FFFE"} {
+		if recorder := serveTestRequest(target); recorder.Code != 200 {
+			t.Errorf("%s: got status %d, want 200", target, recorder.Code)
+		}
+	}
+}
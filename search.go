@@ -0,0 +1,369 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+const searchResultsPerPage = 50
+
+// searchIndex is an inverted index from lowercase words to the codepoints
+// whose name, aliases or block contain them.
+type searchIndex struct {
+	// words is the sorted vocabulary, used for prefix lookups
+	words    []string
+	postings map[string][]searchPosting
+	aliases  map[rune][]string
+}
+
+// searchPosting records that a word was found for codepoint, weight says
+// where: words of the name or of an alias weigh more than words of the block
+// name.
+type searchPosting struct {
+	codepoint rune
+	weight    int
+}
+
+const (
+	searchWeightName  = 4
+	searchWeightAlias = searchWeightName // the only names of control characters
+	searchWeightBlock = 1
+)
+
+// SearchResult is a single codepoint matching a search.
+type SearchResult struct {
+	Codepoint string   `json:"codepoint"`
+	Character string   `json:"character"`
+	Name      string   `json:"name"`
+	Block     string   `json:"block"`
+	Aliases   []string `json:"aliases,omitempty"`
+	// Exact results have a name or alias made of only the query words and
+	// rank before all others, whatever their score.
+	Exact bool `json:"exact"`
+	Score int  `json:"score"`
+}
+
+// SearchResults is one page of a search.
+type SearchResults struct {
+	Query   string         `json:"query"`
+	Page    int            `json:"page"`
+	PerPage int            `json:"per_page"`
+	Total   int            `json:"total"`
+	Pages   int            `json:"pages"`
+	Results []SearchResult `json:"results"`
+	// Sequences are the named sequences whose names match the query.
+	Sequences []NamedSequence `json:"named_sequences"`
+}
+
+var (
+	search     *searchIndex
+	searchOnce sync.Once
+)
+
+// getSearchIndex builds the index on first use. main warms it up in the
+// background so the first search does not pay for it.
+func getSearchIndex() *searchIndex {
+	searchOnce.Do(func() {
+		timer := time.Now()
+		search = newSearchIndex()
+		log.Printf("search: indexed %d words in %s", len(search.words), time.Since(timer))
+	})
+	return search
+}
+
+// searchWords splits a name into lowercase words.
+func searchWords(name string) []string {
+	return strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// assignedCodepoints calls fn for every assigned codepoint that has a name,
+// leaving out surrogates and private use, in codepoint order so the first
+// of two codepoints wins in the indexes built from it.
+func assignedCodepoints(fn func(codepoint rune)) {
+	var ranges []ucdRange
+	add := func(lo, hi, stride rune) {
+		if stride == 1 {
+			ranges = append(ranges, ucdRange{lo: lo, hi: hi})
+			return
+		}
+		for codepoint := lo; codepoint <= hi; codepoint += stride {
+			ranges = append(ranges, ucdRange{lo: codepoint, hi: codepoint})
+		}
+	}
+	for categoryName, categoryRangeTable := range categoryTables() {
+		switch categoryName {
+		case "Cn", "Co", "Cs", "LC":
+			continue
+		}
+		if len(categoryName) != 2 {
+			continue
+		}
+		for _, r16 := range categoryRangeTable.R16 {
+			add(rune(r16.Lo), rune(r16.Hi), rune(r16.Stride))
+		}
+		for _, r32 := range categoryRangeTable.R32 {
+			add(rune(r32.Lo), rune(r32.Hi), rune(r32.Stride))
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+	for _, r := range ranges {
+		for codepoint := r.lo; codepoint <= r.hi; codepoint++ {
+			fn(codepoint)
+		}
+	}
+}
+
+func newSearchIndex() *searchIndex {
+	index := &searchIndex{postings: map[string][]searchPosting{}, aliases: map[rune][]string{}}
+	for _, alias := range nameAliasList() {
+		index.aliases[alias.codepoint] = append(index.aliases[alias.codepoint], alias.alias)
+	}
+
+	assignedCodepoints(func(codepoint rune) {
+		seen := map[string]bool{}
+		add := func(text string, weight int) {
+			for _, word := range searchWords(text) {
+				if seen[word] {
+					continue
+				}
+				seen[word] = true
+				index.postings[word] = append(index.postings[word], searchPosting{codepoint, weight})
+			}
+		}
+
+		add(runeName(codepoint), searchWeightName)
+		for _, alias := range index.aliases[codepoint] {
+			add(alias, searchWeightAlias)
+		}
+		if block, ok := getBlock(codepoint); ok {
+			add(block.value, searchWeightBlock)
+		}
+	})
+
+	for word := range index.postings {
+		index.words = append(index.words, word)
+	}
+	sort.Strings(index.words)
+
+	return index
+}
+
+// matchTerm scores every codepoint with a word starting with term. Whole
+// word matches score double.
+func (index *searchIndex) matchTerm(term string) map[rune]int {
+	scores := map[rune]int{}
+	first := sort.SearchStrings(index.words, term)
+	for _, word := range index.words[first:] {
+		if !strings.HasPrefix(word, term) {
+			break
+		}
+		multiplier := 1
+		if word == term {
+			multiplier = 2
+		}
+		for _, posting := range index.postings[word] {
+			if score := posting.weight * multiplier; score > scores[posting.codepoint] {
+				scores[posting.codepoint] = score
+			}
+		}
+	}
+	return scores
+}
+
+// querySequences returns the named sequences with a word starting with
+// every term of query.
+func querySequences(query string) []NamedSequence {
+	sequences := []NamedSequence{}
+	terms := searchWords(query)
+	if len(terms) == 0 {
+		return sequences
+	}
+
+	list, version := namedSequenceList()
+	for _, sequence := range list {
+		words := searchWords(sequence.name)
+		matches := 0
+		for _, term := range terms {
+			for _, word := range words {
+				if strings.HasPrefix(word, term) {
+					matches++
+					break
+				}
+			}
+		}
+		if matches == len(terms) {
+			sequences = append(sequences, getNamedSequence(sequence, version))
+		}
+	}
+	return sequences
+}
+
+// madeOfTerms reports whether every word of name starts with one of terms,
+// and there are as many of them, i.e. name is all the query asked for.
+func madeOfTerms(name string, terms []string) bool {
+	words := searchWords(name)
+	if len(words) != len(terms) {
+		return false
+	}
+	for _, word := range words {
+		matched := false
+		for _, term := range terms {
+			matched = matched || strings.HasPrefix(word, term)
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// query returns every codepoint matching all the words of query, best
+// matches first.
+func (index *searchIndex) query(query string) []SearchResult {
+	terms := searchWords(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	var scores map[rune]int
+	for _, term := range terms {
+		termScores := index.matchTerm(term)
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+		for codepoint, score := range scores {
+			termScore, ok := termScores[codepoint]
+			if !ok {
+				delete(scores, codepoint)
+				continue
+			}
+			scores[codepoint] = score + termScore
+		}
+	}
+
+	results := make([]SearchResult, 0, len(scores))
+	for codepoint, score := range scores {
+		name := runeName(codepoint)
+		exact := madeOfTerms(name, terms)
+		for _, alias := range index.aliases[codepoint] {
+			exact = exact || madeOfTerms(alias, terms)
+		}
+		results = append(results, SearchResult{
+			Codepoint: fmt.Sprintf("%U", codepoint),
+			Character: string(codepoint),
+			Name:      name,
+			Exact:     exact,
+			Score:     score,
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Exact != results[j].Exact {
+			return results[i].Exact
+		}
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		if len(results[i].Name) != len(results[j].Name) {
+			return len(results[i].Name) < len(results[j].Name)
+		}
+		return []rune(results[i].Character)[0] < []rune(results[j].Character)[0]
+	})
+
+	return results
+}
+
+func getSearchResults(request *http.Request) SearchResults {
+	query := strings.TrimSpace(request.URL.Query().Get("q"))
+	page, err := strconv.Atoi(request.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	results := getSearchIndex().query(query)
+
+	data := SearchResults{
+		Query:   query,
+		Page:    page,
+		PerPage: searchResultsPerPage,
+		Total:   len(results),
+		Pages:   (len(results) + searchResultsPerPage - 1) / searchResultsPerPage,
+		Results: []SearchResult{},
+
+		Sequences: querySequences(query),
+	}
+
+	start := (page - 1) * searchResultsPerPage
+	if start < len(results) {
+		end := start + searchResultsPerPage
+		if end > len(results) {
+			end = len(results)
+		}
+		data.Results = results[start:end]
+	}
+	for i := range data.Results {
+		codepoint := []rune(data.Results[i].Character)[0]
+		if block, ok := getBlock(codepoint); ok {
+			data.Results[i].Block = block.value
+		}
+		for _, alias := range getNameAliases(codepoint) {
+			data.Results[i].Aliases = append(data.Results[i].Aliases, alias.Alias)
+		}
+	}
+
+	return data
+}
+
+func serveSearchJSON(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	writer = setJSONHeaders(writer)
+	serveJSON(writer, request, getSearchResults(request), timer)
+}
+
+func serveSearch(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	writer.Header().Add("Vary", "Accept")
+	if wantsJSON(request) {
+		serveSearchJSON(writer, request, timer)
+		return
+	}
+
+	writer = setHeaders(writer)
+
+	results := getSearchResults(request)
+	data := struct {
+		SearchResults
+		UnicodeVersion string
+		PreviousPage   string
+		NextPage       string
+	}{
+		SearchResults:  results,
+		UnicodeVersion: unicodeVersion(),
+	}
+	if results.Page > 1 {
+		data.PreviousPage = searchPageURL(results.Query, results.Page-1)
+	}
+	if results.Page < results.Pages {
+		data.NextPage = searchPageURL(results.Query, results.Page+1)
+	}
+
+	templateFiles := []string{
+		"./template/base.template.html",
+		"./template/search.template.html",
+	}
+
+	serveFilesFromTemplate(writer, request, templateFiles, data, timer)
+}
+
+func searchPageURL(query string, page int) string {
+	return "/search?" + url.Values{"q": {query}, "page": {strconv.Itoa(page)}}.Encode()
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestSearchIndex finds codepoints by words of their name, of their aliases
+// and of their block.
+func TestSearchIndex(t *testing.T) {
+	for query, want := range map[string]rune{
+		"leftwards double arrow": 0x21D0,
+		"latin capital letter a": 0x41,
+		"line feed":              0x0A,
+		"nbsp":                   0xA0,
+		"byte order mark":        0xFEFF,
+		"arrow double left":      0x21D0,
+	} {
+		results := getSearchIndex().query(query)
+		if len(results) == 0 {
+			t.Errorf("%q: no results", query)
+			continue
+		}
+		if results[0].Codepoint != getCodepointLink(want).Codepoint {
+			t.Errorf("%q: got %s %s first, want %U", query, results[0].Codepoint, results[0].Name, want)
+		}
+	}
+
+	// "REVERSE LINE FEED" has more words than the query and U+008D's other
+	// alias "REVERSE INDEX" other words, neither is an exact match
+	exact := map[string]bool{}
+	for _, result := range getSearchIndex().query("line feed") {
+		exact[result.Codepoint] = result.Exact
+	}
+	if !exact["U+000A"] || exact["U+008D"] {
+		t.Errorf(`"line feed": U+000A exact %t, U+008D exact %t`, exact["U+000A"], exact["U+008D"])
+	}
+
+	// exact matches rank first even when others, like U+21D4 LEFT RIGHT
+	// DOUBLE ARROW, score more
+	results := getSearchIndex().query("arrow double left")
+	for i, result := range results {
+		if !result.Exact && i+1 < len(results) && results[i+1].Exact {
+			t.Errorf(`"arrow double left": %s %s ranks before exact %s`, result.Codepoint, result.Name, results[i+1].Codepoint)
+			break
+		}
+	}
+
+	// the block name is the only place "supplement" is found for U+0080
+	found := false
+	for _, result := range getSearchIndex().query("latin supplement") {
+		found = found || result.Codepoint == "U+0080"
+	}
+	if !found {
+		t.Error(`"latin supplement" does not find U+0080 by its block`)
+	}
+}
+
+func TestSearchPages(t *testing.T) {
+	var first, second SearchResults
+	for target, results := range map[string]*SearchResults{
+		"/api/v1/search?q=cyrillic":        &first,
+		"/api/v1/search?q=cyrillic&page=2": &second,
+	} {
+		recorder := serveTestRequest(target)
+		if err := json.NewDecoder(recorder.Body).Decode(results); err != nil {
+			t.Fatalf("%s: got status %d, %v", target, recorder.Code, err)
+		}
+	}
+	if first.Total <= searchResultsPerPage || first.Pages < 2 {
+		t.Fatalf("got %d results on %d pages, want more than one page", first.Total, first.Pages)
+	}
+	if len(first.Results) != searchResultsPerPage || len(second.Results) == 0 {
+		t.Fatalf("got %d and %d results on pages 1 and 2", len(first.Results), len(second.Results))
+	}
+	if first.Results[0].Codepoint == second.Results[0].Codepoint {
+		t.Errorf("pages 1 and 2 both start with %s", first.Results[0].Codepoint)
+	}
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// Segmentation is the break properties of a single codepoint.
+type Segmentation struct {
+	GraphemeClusterBreak string `json:"grapheme_cluster_break"`
+	WordBreak            string `json:"word_break"`
+}
+
+// Segment is a piece of a segmented string. Start and End are byte offsets.
+// Mandatory is only set for line segments that end in a hard line break.
+type Segment struct {
+	Text      string `json:"text"`
+	Start     int    `json:"start"`
+	End       int    `json:"end"`
+	Mandatory bool   `json:"mandatory,omitempty"`
+}
+
+// SegmentedCodepoint is one codepoint of a segmented string with the
+// boundaries found right before it.
+type SegmentedCodepoint struct {
+	Offset    int    `json:"offset"`
+	Codepoint string `json:"codepoint"`
+	Character string `json:"character"`
+	Segmentation
+
+	GraphemeBoundary bool `json:"grapheme_boundary"`
+	WordBoundary     bool `json:"word_boundary"`
+	SentenceBoundary bool `json:"sentence_boundary"`
+	LineBreak        bool `json:"line_break"`
+}
+
+// SegmentedString is the result of the /segment tool. Graphemes and Words
+// are found with the same break tables as the properties shown, Sentences
+// and Lines by uniseg, whose tables are of Unicode UnisegVersion.
+type SegmentedString struct {
+	Input         string `json:"input"`
+	UnisegVersion string `json:"uniseg_unicode_version"`
+
+	Graphemes  []Segment            `json:"graphemes"`
+	Words      []Segment            `json:"words"`
+	Sentences  []Segment            `json:"sentences"`
+	Lines      []Segment            `json:"lines"`
+	Codepoints []SegmentedCodepoint `json:"codepoints"`
+}
+
+func getGraphemeClusterBreak(codepoint rune) string {
+	breaks := builtinGraphemeBreak
+	if ucd != nil && ucd.graphemeBreaks != nil {
+		breaks = ucd.graphemeBreaks
+	}
+	if r, ok := findUCDRange(breaks, codepoint); ok {
+		return r.value
+	}
+	return "Other"
+}
+
+func getWordBreak(codepoint rune) string {
+	breaks := builtinWordBreak
+	if ucd != nil && ucd.wordBreaks != nil {
+		breaks = ucd.wordBreaks
+	}
+	if r, ok := findUCDRange(breaks, codepoint); ok {
+		return r.value
+	}
+	return "Other"
+}
+
+func getSegmentation(codepoint rune) Segmentation {
+	return Segmentation{
+		GraphemeClusterBreak: getGraphemeClusterBreak(codepoint),
+		WordBreak:            getWordBreak(codepoint),
+	}
+}
+
+// unisegVersion is the Unicode version of the tables of the uniseg release
+// in go.mod, v0.4.7.
+const unisegVersion = "15.0.0"
+
+// segmentAt cuts input before the codepoints boundaries marks, offsets are
+// the byte offsets of the codepoints, and marks the boundaries in
+// boundaryOffsets.
+func segmentAt(input string, offsets []int, boundaries []bool, boundaryOffsets map[int]bool) []Segment {
+	segments := []Segment{}
+	for i, offset := range offsets {
+		if !boundaries[i] {
+			continue
+		}
+		boundaryOffsets[offset] = true
+		if len(segments) > 0 {
+			segments[len(segments)-1].End = offset
+			segments[len(segments)-1].Text = input[segments[len(segments)-1].Start:offset]
+		}
+		segments = append(segments, Segment{Start: offset})
+	}
+	if len(segments) > 0 {
+		segments[len(segments)-1].End = len(input)
+		segments[len(segments)-1].Text = input[segments[len(segments)-1].Start:]
+	}
+	return segments
+}
+
+// segmentString cuts input with next, one of the First...InString functions
+// of uniseg wrapped to a common signature, and marks the boundaries it finds
+// in boundaries.
+func segmentString(input string, boundaries map[int]bool, next func(text string, state int) (segment, rest string, mandatory bool, newState int)) []Segment {
+	segments := []Segment{}
+	offset, state := 0, -1
+	for rest := input; rest != ""; {
+		var segment string
+		var mandatory bool
+		segment, rest, mandatory, state = next(rest, state)
+		boundaries[offset] = true
+		segments = append(segments, Segment{segment, offset, offset + len(segment), mandatory})
+		offset += len(segment)
+	}
+	return segments
+}
+
+func segment(input string) SegmentedString {
+	graphemeBoundaryOffsets := map[int]bool{}
+	wordBoundaryOffsets := map[int]bool{}
+	sentenceBoundaries := map[int]bool{}
+	lineBreaks := map[int]bool{}
+
+	var runes []rune
+	var offsets []int
+	for offset, codepoint := range input {
+		runes, offsets = append(runes, codepoint), append(offsets, offset)
+	}
+
+	data := SegmentedString{
+		Input:         input,
+		UnisegVersion: unisegVersion,
+		Graphemes:     segmentAt(input, offsets, graphemeBoundaries(runes), graphemeBoundaryOffsets),
+		Words:         segmentAt(input, offsets, wordBoundaries(runes), wordBoundaryOffsets),
+		Sentences: segmentString(input, sentenceBoundaries, func(text string, state int) (string, string, bool, int) {
+			sentence, rest, state := uniseg.FirstSentenceInString(text, state)
+			return sentence, rest, false, state
+		}),
+		Lines:      segmentString(input, lineBreaks, uniseg.FirstLineSegmentInString),
+		Codepoints: []SegmentedCodepoint{},
+	}
+
+	for offset, codepoint := range input {
+		segmented := SegmentedCodepoint{
+			Offset:    offset,
+			Codepoint: fmt.Sprintf("%U", codepoint),
+			Character: string(codepoint),
+
+			GraphemeBoundary: graphemeBoundaryOffsets[offset],
+			WordBoundary:     wordBoundaryOffsets[offset],
+			SentenceBoundary: sentenceBoundaries[offset],
+			LineBreak:        lineBreaks[offset],
+		}
+		// only invalid bytes are blanked, not a U+FFFD in the input
+		if _, size := utf8.DecodeRuneInString(input[offset:]); codepoint == utf8.RuneError && size == 1 {
+			segmented.Codepoint = ""
+		} else {
+			segmented.Segmentation = getSegmentation(codepoint)
+		}
+		data.Codepoints = append(data.Codepoints, segmented)
+	}
+
+	return data
+}
+
+func serveSegmentJSON(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	input, err := getStringInput(request)
+	if err != nil {
+		serveJSONError(writer, request, err, timer)
+		return
+	}
+
+	writer = setJSONHeaders(writer)
+	serveJSON(writer, request, segment(input), timer)
+}
+
+func serveSegment(writer http.ResponseWriter, request *http.Request, timer time.Time) {
+	writer.Header().Add("Vary", "Accept")
+	if wantsJSON(request) {
+		serveSegmentJSON(writer, request, timer)
+		return
+	}
+
+	input, err := getStringInput(request)
+	if err != nil {
+		serveError(writer, request, err, timer)
+		return
+	}
+
+	writer = setHeaders(writer)
+
+	data := struct {
+		SegmentedString
+		UnicodeVersion string
+	}{
+		SegmentedString: segment(input),
+		UnicodeVersion:  unicodeVersion(),
+	}
+
+	templateFiles := []string{
+		"./template/base.template.html",
+		"./template/segment.template.html",
+	}
+
+	serveFilesFromTemplate(writer, request, templateFiles, data, timer)
+}
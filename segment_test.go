@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSegmentReplacementCharacter(t *testing.T) {
+	segmented := segment("�a\xff")
+	if len(segmented.Codepoints) != 3 {
+		t.Fatalf("got %d codepoints, want 3", len(segmented.Codepoints))
+	}
+
+	replacement := segmented.Codepoints[0]
+	if replacement.Codepoint != "U+FFFD" || replacement.Segmentation.GraphemeClusterBreak == "" {
+		t.Errorf("U+FFFD: got codepoint %q with %+v, want U+FFFD with segmentation data", replacement.Codepoint, replacement.Segmentation)
+	}
+	if invalid := segmented.Codepoints[2]; invalid.Codepoint != "" {
+		t.Errorf("invalid byte: got codepoint %q, want none", invalid.Codepoint)
+	}
+}
+
+// readBreakTest reads a gzipped GraphemeBreakTest.txt or WordBreakTest.txt:
+// every line alternates ÷ or × with the hexadecimal codepoints they are
+// between.
+func readBreakTest(t *testing.T, path string) (cases [][]rune, want [][]bool) {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	reader, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		var runes []rune
+		var boundaries []bool
+		for i := 1; i < len(fields)-1; i += 2 {
+			codepoint, err := strconv.ParseUint(fields[i], 16, 32)
+			if err != nil {
+				t.Fatalf("%s: %v", line, err)
+			}
+			runes = append(runes, rune(codepoint))
+			boundaries = append(boundaries, fields[i-1] == "÷")
+		}
+		cases, want = append(cases, runes), append(want, boundaries)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return cases, want
+}
+
+func TestBreakConformance(t *testing.T) {
+	for path, boundaries := range map[string]func([]rune) []bool{
+		"testdata/GraphemeBreakTest.txt.gz": graphemeBoundaries,
+		"testdata/WordBreakTest.txt.gz":     wordBoundaries,
+	} {
+		cases, want := readBreakTest(t, path)
+		failures := 0
+		for i, runes := range cases {
+			if got := boundaries(runes); !reflect.DeepEqual(got, want[i]) && failures < 10 {
+				t.Errorf("%s: %U: got %v, want %v", path, runes, got, want[i])
+				failures++
+			}
+		}
+	}
+}
+
+func TestServeSegmentTables(t *testing.T) {
+	// KA, VIRAMA and SSA are one cluster since GB9c of Unicode 15.1, which
+	// uniseg's 15.0 tables do not have
+	segmented := segment("क्ष")
+	if len(segmented.Graphemes) != 1 {
+		t.Errorf("got graphemes %+v, want one", segmented.Graphemes)
+	}
+
+	body := serveTestRequest("/segment?s=a").Body.String()
+	if want := "graphemes and words from Unicode " + unicodeVersion(); !strings.Contains(body, want) {
+		t.Errorf("page does not say %q", want)
+	}
+	if want := "sentences and line breaks from Unicode " + unisegVersion; !strings.Contains(body, want) {
+		t.Errorf("page does not say %q", want)
+	}
+}
@@ -1,9 +1,18 @@
 package main
 
-import "unicode"
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
 
 func getCategoryData(codepoint rune) (majorCategoryLiteral string, categoryLiteral string, categories []string, majorCategories []string) {
-	for categoryName, categoryRangeTable := range unicode.Categories {
+	for categoryName, categoryRangeTable := range categoryTables() {
+		// LC (cased letter) overlaps Ll, Lt and Lu
+		if categoryName == "LC" {
+			continue
+		}
 		if unicode.Is(categoryRangeTable, codepoint) {
 			if len(categoryName) == 1 {
 				switch categoryName {
@@ -47,6 +56,9 @@ func getCategoryData(codepoint rune) (majorCategoryLiteral string, categoryLiter
 				case "Cs":
 					categoryName = "Surrogate (Cs)"
 					categoryLiteral = (categoryLiteral + "cs")
+				case "Cn":
+					categoryName = "Unassigned (Cn)"
+					categoryLiteral = (categoryLiteral + "cn")
 				// THE Ns
 				case "Nd":
 					categoryName = "Decimal (Nd)"
@@ -141,501 +153,208 @@ func getCategoryData(codepoint rune) (majorCategoryLiteral string, categoryLiter
 	return
 }
 
-func getRangeTableLiteral(route string) (rtLiteral *unicode.RangeTable) {
-	switch route {
-	case "adlam":
-		rtLiteral = unicode.Adlam
-	case "ahom":
-		rtLiteral = unicode.Ahom
-	case "anatolian_hieroglyphs":
-		rtLiteral = unicode.Anatolian_Hieroglyphs
-	case "arabic":
-		rtLiteral = unicode.Arabic
-	case "armenian":
-		rtLiteral = unicode.Armenian
-	case "avestan":
-		rtLiteral = unicode.Avestan
-	case "balinese":
-		rtLiteral = unicode.Balinese
-	case "bamum":
-		rtLiteral = unicode.Bamum
-	case "bassa_vah":
-		rtLiteral = unicode.Bassa_Vah
-	case "batak":
-		rtLiteral = unicode.Batak
-	case "bengali":
-		rtLiteral = unicode.Bengali
-	case "bhaiksuki":
-		rtLiteral = unicode.Bhaiksuki
-	case "bopomofo":
-		rtLiteral = unicode.Bopomofo
-	case "brahmi":
-		rtLiteral = unicode.Brahmi
-	case "braille":
-		rtLiteral = unicode.Braille
-	case "buginese":
-		rtLiteral = unicode.Buginese
-	case "buhid":
-		rtLiteral = unicode.Buhid
-	case "canadian_aboriginal":
-		rtLiteral = unicode.Canadian_Aboriginal
-	case "carian":
-		rtLiteral = unicode.Carian
-	case "caucasian_albanian":
-		rtLiteral = unicode.Caucasian_Albanian
-	case "chakma":
-		rtLiteral = unicode.Chakma
-	case "cham":
-		rtLiteral = unicode.Cham
-	case "cherokee":
-		rtLiteral = unicode.Cherokee
-	case "chorasmian":
-		rtLiteral = unicode.Chorasmian
-	case "common":
-		rtLiteral = unicode.Common
-	case "coptic":
-		rtLiteral = unicode.Coptic
-	case "cuneiform":
-		rtLiteral = unicode.Cuneiform
-	case "cypriot":
-		rtLiteral = unicode.Cypriot
-	case "cyrillic":
-		rtLiteral = unicode.Cyrillic
-	case "deseret":
-		rtLiteral = unicode.Deseret
-	case "devanagari":
-		rtLiteral = unicode.Devanagari
-	case "dives_akuru":
-		rtLiteral = unicode.Dives_Akuru
-	case "dogra":
-		rtLiteral = unicode.Dogra
-	case "duployan":
-		rtLiteral = unicode.Duployan
-	case "egyptian_hieroglyphs":
-		rtLiteral = unicode.Egyptian_Hieroglyphs
-	case "elbasan":
-		rtLiteral = unicode.Elbasan
-	case "elymaic":
-		rtLiteral = unicode.Elymaic
-	case "ethiopic":
-		rtLiteral = unicode.Ethiopic
-	case "georgian":
-		rtLiteral = unicode.Georgian
-	case "glagolitic":
-		rtLiteral = unicode.Glagolitic
-	case "gothic":
-		rtLiteral = unicode.Gothic
-	case "grantha":
-		rtLiteral = unicode.Grantha
-	case "greek":
-		rtLiteral = unicode.Greek
-	case "gujarati":
-		rtLiteral = unicode.Gujarati
-	case "gunjala_gondi":
-		rtLiteral = unicode.Gunjala_Gondi
-	case "gurmukhi":
-		rtLiteral = unicode.Gurmukhi
-	case "han":
-		rtLiteral = unicode.Han
-	case "hangul":
-		rtLiteral = unicode.Hangul
-	case "hanifi_rohingya":
-		rtLiteral = unicode.Hanifi_Rohingya
-	case "hanunoo":
-		rtLiteral = unicode.Hanunoo
-	case "hatran":
-		rtLiteral = unicode.Hatran
-	case "hebrew":
-		rtLiteral = unicode.Hebrew
-	case "hiragana":
-		rtLiteral = unicode.Hiragana
-	case "imperial_aramaic":
-		rtLiteral = unicode.Imperial_Aramaic
-	case "inherited":
-		rtLiteral = unicode.Inherited
-	case "inscriptional_pahlavi":
-		rtLiteral = unicode.Inscriptional_Pahlavi
-	case "inscriptional_parthian":
-		rtLiteral = unicode.Inscriptional_Parthian
-	case "javanese":
-		rtLiteral = unicode.Javanese
-	case "kaithi":
-		rtLiteral = unicode.Kaithi
-	case "kannada":
-		rtLiteral = unicode.Kannada
-	case "katakana":
-		rtLiteral = unicode.Katakana
-	case "kayah_li":
-		rtLiteral = unicode.Kayah_Li
-	case "kharoshthi":
-		rtLiteral = unicode.Kharoshthi
-	case "khitan_small_script":
-		rtLiteral = unicode.Khitan_Small_Script
-	case "khmer":
-		rtLiteral = unicode.Khmer
-	case "khojki":
-		rtLiteral = unicode.Khojki
-	case "khudawadi":
-		rtLiteral = unicode.Khudawadi
-	case "lao":
-		rtLiteral = unicode.Lao
-	case "latin":
-		rtLiteral = unicode.Latin
-	case "lepcha":
-		rtLiteral = unicode.Lepcha
-	case "limbu":
-		rtLiteral = unicode.Limbu
-	case "linear_a":
-		rtLiteral = unicode.Linear_A
-	case "linear_b":
-		rtLiteral = unicode.Linear_B
-	case "lisu":
-		rtLiteral = unicode.Lisu
-	case "lycian":
-		rtLiteral = unicode.Lycian
-	case "lydian":
-		rtLiteral = unicode.Lydian
-	case "mahajani":
-		rtLiteral = unicode.Mahajani
-	case "makasar":
-		rtLiteral = unicode.Makasar
-	case "malayalam":
-		rtLiteral = unicode.Malayalam
-	case "mandaic":
-		rtLiteral = unicode.Mandaic
-	case "manichaean":
-		rtLiteral = unicode.Manichaean
-	case "marchen":
-		rtLiteral = unicode.Marchen
-	case "masaram_gondi":
-		rtLiteral = unicode.Masaram_Gondi
-	case "medefaidrin":
-		rtLiteral = unicode.Medefaidrin
-	case "meetei_mayek":
-		rtLiteral = unicode.Meetei_Mayek
-	case "mende_kikakui":
-		rtLiteral = unicode.Mende_Kikakui
-	case "meroitic_cursive":
-		rtLiteral = unicode.Meroitic_Cursive
-	case "meroitic_hieroglyphs":
-		rtLiteral = unicode.Meroitic_Hieroglyphs
-	case "miao":
-		rtLiteral = unicode.Miao
-	case "modi":
-		rtLiteral = unicode.Modi
-	case "mongolian":
-		rtLiteral = unicode.Mongolian
-	case "mro":
-		rtLiteral = unicode.Mro
-	case "multani":
-		rtLiteral = unicode.Multani
-	case "myanmar":
-		rtLiteral = unicode.Myanmar
-	case "nabataean":
-		rtLiteral = unicode.Nabataean
-	case "nandinagar":
-		rtLiteral = unicode.Nandinagari
-	case "new_tai_lue":
-		rtLiteral = unicode.New_Tai_Lue
-	case "newa":
-		rtLiteral = unicode.Newa
-	case "nko":
-		rtLiteral = unicode.Nko
-	case "nushu":
-		rtLiteral = unicode.Nushu
-	case "nyiakeng_puachue_hmong":
-		rtLiteral = unicode.Nyiakeng_Puachue_Hmong
-	case "ogham":
-		rtLiteral = unicode.Ogham
-	case "ol_chiki":
-		rtLiteral = unicode.Ol_Chiki
-	case "old_hungarian":
-		rtLiteral = unicode.Old_Hungarian
-	case "old_italic":
-		rtLiteral = unicode.Old_Italic
-	case "old_north_arabian":
-		rtLiteral = unicode.Old_North_Arabian
-	case "old_permic":
-		rtLiteral = unicode.Old_Permic
-	case "old_persian":
-		rtLiteral = unicode.Old_Persian
-	case "old_sogdian":
-		rtLiteral = unicode.Old_Sogdian
-	case "old_south_arabian":
-		rtLiteral = unicode.Old_South_Arabian
-	case "old_turkic":
-		rtLiteral = unicode.Old_Turkic
-	case "oriya":
-		rtLiteral = unicode.Oriya
-	case "osage":
-		rtLiteral = unicode.Osage
-	case "osmanya":
-		rtLiteral = unicode.Osmanya
-	case "pahawh_hmong":
-		rtLiteral = unicode.Pahawh_Hmong
-	case "palmyrene":
-		rtLiteral = unicode.Palmyrene
-	case "pau_cin_hau":
-		rtLiteral = unicode.Pau_Cin_Hau
-	case "phags_pa":
-		rtLiteral = unicode.Phags_Pa
-	case "phoenician":
-		rtLiteral = unicode.Phoenician
-	case "psalter_pahlavi":
-		rtLiteral = unicode.Psalter_Pahlavi
-	case "rejang":
-		rtLiteral = unicode.Rejang
-	case "runic":
-		rtLiteral = unicode.Runic
-	case "samaritan":
-		rtLiteral = unicode.Samaritan
-	case "saurashtra":
-		rtLiteral = unicode.Saurashtra
-	case "sharada":
-		rtLiteral = unicode.Sharada
-	case "shavian":
-		rtLiteral = unicode.Shavian
-	case "siddham":
-		rtLiteral = unicode.Siddham
-	case "signwriting":
-		rtLiteral = unicode.SignWriting
-	case "sinhala":
-		rtLiteral = unicode.Sinhala
-	case "sogdian":
-		rtLiteral = unicode.Sogdian
-	case "sora_sompeng":
-		rtLiteral = unicode.Sora_Sompeng
-	case "soyombo":
-		rtLiteral = unicode.Soyombo
-	case "sundanese":
-		rtLiteral = unicode.Sundanese
-	case "syloti_nagri":
-		rtLiteral = unicode.Syloti_Nagri
-	case "syriac":
-		rtLiteral = unicode.Syriac
-	case "tagalog":
-		rtLiteral = unicode.Tagalog
-	case "tagbanwa":
-		rtLiteral = unicode.Tagbanwa
-	case "tai_le":
-		rtLiteral = unicode.Tai_Le
-	case "tai_tham":
-		rtLiteral = unicode.Tai_Tham
-	case "tai_viet":
-		rtLiteral = unicode.Tai_Viet
-	case "takri":
-		rtLiteral = unicode.Takri
-	case "tamil":
-		rtLiteral = unicode.Tamil
-	case "tangut":
-		rtLiteral = unicode.Tangut
-	case "telugu":
-		rtLiteral = unicode.Telugu
-	case "thaana":
-		rtLiteral = unicode.Thaana
-	case "thai":
-		rtLiteral = unicode.Thai
-	case "tibetan":
-		rtLiteral = unicode.Tibetan
-	case "tifinagh":
-		rtLiteral = unicode.Tifinagh
-	case "tirhuta":
-		rtLiteral = unicode.Tirhuta
-	case "ugaritic":
-		rtLiteral = unicode.Ugaritic
-	case "vai":
-		rtLiteral = unicode.Vai
-	case "wancho":
-		rtLiteral = unicode.Wancho
-	case "warang_citi":
-		rtLiteral = unicode.Warang_Citi
-	case "yezidi":
-		rtLiteral = unicode.Yezidi
-	case "yi":
-		rtLiteral = unicode.Yi
-	case "zanabazar_square":
-		rtLiteral = unicode.Zanabazar_Square
-
-		// properties
-
-	case "ascii_hex_digit":
-		rtLiteral = unicode.ASCII_Hex_Digit
-	case "bidi_control":
-		rtLiteral = unicode.Bidi_Control
-	case "dash":
-		rtLiteral = unicode.Dash
-	case "deprecated":
-		rtLiteral = unicode.Deprecated
-	case "diacritic":
-		rtLiteral = unicode.Diacritic
-	case "extender":
-		rtLiteral = unicode.Extender
-	case "hex_digit":
-		rtLiteral = unicode.Hex_Digit
-	case "hyphen":
-		rtLiteral = unicode.Hyphen
-	case "ids_binary_operator":
-		rtLiteral = unicode.IDS_Binary_Operator
-	case "ids_trinary_operator":
-		rtLiteral = unicode.IDS_Trinary_Operator
-	case "ideographic":
-		rtLiteral = unicode.Ideographic
-	case "join_control":
-		rtLiteral = unicode.Join_Control
-	case "logical_order_exception":
-		rtLiteral = unicode.Logical_Order_Exception
-		/* 	this causes the server to fail epicly
-		case "noncharacter_code_point":
-			rtLiteral = unicode.Noncharacter_Code_Point */
-	case "other_alphabetic":
-		rtLiteral = unicode.Other_Alphabetic
-	case "other_default_ignorable_code_point":
-		rtLiteral = unicode.Other_Default_Ignorable_Code_Point
-	case "other_grapheme_extend":
-		rtLiteral = unicode.Other_Grapheme_Extend
-	case "other_id_continue":
-		rtLiteral = unicode.Other_ID_Continue
-	case "other_id_start":
-		rtLiteral = unicode.Other_ID_Start
-	case "other_lowercase":
-		rtLiteral = unicode.Other_Lowercase
-	case "other_math":
-		rtLiteral = unicode.Other_Math
-	case "other_uppercase":
-		rtLiteral = unicode.Other_Uppercase
-	case "pattern_syntax":
-		rtLiteral = unicode.Pattern_Syntax
-	case "pattern_white_space":
-		rtLiteral = unicode.Pattern_White_Space
-	case "prepended_concatenation_mark":
-		rtLiteral = unicode.Prepended_Concatenation_Mark
-	case "quotation_mark":
-		rtLiteral = unicode.Quotation_Mark
-	case "radical":
-		rtLiteral = unicode.Radical
-	case "regional_indicator":
-		rtLiteral = unicode.Regional_Indicator
-	case "sterm":
-		rtLiteral = unicode.Sentence_Terminal
-	case "sentence_terminal":
-		rtLiteral = unicode.Sentence_Terminal
-	case "soft_dotted":
-		rtLiteral = unicode.Soft_Dotted
-	case "terminal_punctuation":
-		rtLiteral = unicode.Terminal_Punctuation
-	case "unified_ideograph":
-		rtLiteral = unicode.Unified_Ideograph
-	case "variation_selector":
-		rtLiteral = unicode.Variation_Selector
-	case "white_space":
-		rtLiteral = unicode.White_Space
-
-		// categories
-
-	case "cc":
-		rtLiteral = unicode.Cc
-	case "cf":
-		rtLiteral = unicode.Cf
-	case "co":
-		rtLiteral = unicode.Co
-	case "cs":
-		rtLiteral = unicode.Cs
-	case "nd":
-		rtLiteral = unicode.Nd
-	case "letter":
-		rtLiteral = unicode.L
-	case "l":
-		rtLiteral = unicode.L
-	case "lm":
-		rtLiteral = unicode.Lm
-	case "lo":
-		rtLiteral = unicode.Lo
-	case "lower":
-		rtLiteral = unicode.Ll
-	case "ll":
-		rtLiteral = unicode.Ll
-	case "mark":
-		rtLiteral = unicode.M
-	case "m":
-		rtLiteral = unicode.M
-	case "mc":
-		rtLiteral = unicode.Mc
-	case "me":
-		rtLiteral = unicode.Me
-	case "mn":
-		rtLiteral = unicode.Mn
-	case "nl":
-		rtLiteral = unicode.Nl
-	case "no":
-		rtLiteral = unicode.No
-	case "number":
-		rtLiteral = unicode.N
-	case "n":
-		rtLiteral = unicode.N
-	case "other":
-		rtLiteral = unicode.C
-	case "c":
-		rtLiteral = unicode.C
-	case "pc":
-		rtLiteral = unicode.Pc
-	case "pd":
-		rtLiteral = unicode.Pd
-	case "pe":
-		rtLiteral = unicode.Pe
-	case "pf":
-		rtLiteral = unicode.Pf
-	case "pi":
-		rtLiteral = unicode.Pi
-	case "po":
-		rtLiteral = unicode.Po
-	case "ps":
-		rtLiteral = unicode.Ps
-	case "punct":
-		rtLiteral = unicode.P
-	case "p":
-		rtLiteral = unicode.P
-	case "sc":
-		rtLiteral = unicode.Sc
-	case "sk":
-		rtLiteral = unicode.Sk
-	case "sm":
-		rtLiteral = unicode.Sm
-	case "so":
-		rtLiteral = unicode.So
-	case "space":
-		rtLiteral = unicode.Z
-	case "z":
-		rtLiteral = unicode.Z
-	case "symbol":
-		rtLiteral = unicode.S
-	case "s":
-		rtLiteral = unicode.S
-	case "title":
-		rtLiteral = unicode.Lt
-	case "lt":
-		rtLiteral = unicode.Lt
-	case "upper":
-		rtLiteral = unicode.Lu
-	case "lu":
-		rtLiteral = unicode.Lu
-	case "zl":
-		rtLiteral = unicode.Zl
-	case "zp":
-		rtLiteral = unicode.Zp
-	case "zs":
-		rtLiteral = unicode.Zs
-
-		// custom
-
-	case "control":
-		rtLiteral = unicode.Cc
-	case "digit":
-		rtLiteral = unicode.Nd
-
-	default:
-		rtLiteral = unicode.Latin
-		route = "latin"
+// getGeneralCategory returns the two letter General_Category of a codepoint,
+// "Cn" for unassigned ones.
+func getGeneralCategory(codepoint rune) string {
+	for categoryName, categoryRangeTable := range categoryTables() {
+		if len(categoryName) == 2 && categoryName != "LC" && unicode.Is(categoryRangeTable, codepoint) {
+			return categoryName
+		}
 	}
+	return "Cn"
+}
 
-	return
+// getScripts returns the sorted names of the scripts containing codepoint.
+func getScripts(codepoint rune) []string {
+	scripts := []string{}
+	for scriptName, scriptRangeTable := range scriptTables() {
+		if unicode.Is(scriptRangeTable, codepoint) {
+			scripts = append(scripts, scriptName)
+		}
+	}
+	sort.Strings(scripts)
+	return scripts
+}
+
+// getScriptExtensions returns the sorted names of every script codepoint is
+// used with. ScriptExtensions.txt only lists the codepoints that are used
+// with other scripts than their own, the rest fall back to getScripts.
+func getScriptExtensions(codepoint rune) []string {
+	extensions, ok := findUCDRange(scriptExtensionList(), codepoint)
+	if !ok {
+		return getScripts(codepoint)
+	}
+
+	scripts := []string{}
+	for _, short := range strings.Fields(extensions.value) {
+		scripts = append(scripts, scriptLongName(short))
+	}
+	sort.Strings(scripts)
+	return scripts
+}
+
+// scriptLongName turns a short script name such as "Deva" into the long one
+// that names the script tables.
+func scriptLongName(short string) string {
+	for _, alias := range propertyValueAliases() {
+		if alias.property == "sc" && len(alias.names) > 1 && alias.names[0] == short {
+			return alias.names[1]
+		}
+	}
+	return short
+}
+
+// rangeEntry is a named range table that can be browsed under /range.
+type rangeEntry struct {
+	Name  string
+	Kind  string
+	Table *unicode.RangeTable
+}
+
+const (
+	rangeKindScript   = "script"
+	rangeKindCategory = "category"
+	rangeKindProperty = "property"
+)
+
+// rangeAliases are the friendly names unicode.click has always accepted on
+// top of the official aliases.
+var rangeAliases = map[string]string{
+	"letter":  "l",
+	"lower":   "ll",
+	"upper":   "lu",
+	"title":   "lt",
+	"mark":    "m",
+	"number":  "n",
+	"other":   "c",
+	"punct":   "p",
+	"space":   "z",
+	"symbol":  "s",
+	"control": "cc",
+	"digit":   "nd",
+
+	"nandinagar": "nandinagari",
+}
+
+// hiddenFromIndex are ranges that can be browsed but are not linked from the
+// index page.
+var hiddenFromIndex = map[string]bool{
+	"noncharacter_code_point": true,
+}
+
+type rangeRegistry struct {
+	entries map[string]*rangeEntry
+	byLoose map[string]*rangeEntry
+}
+
+var (
+	registry     *rangeRegistry
+	registryOnce sync.Once
+)
+
+// getRangeRegistry builds the registry on first use, which happens after
+// the UCD has been loaded in main.
+func getRangeRegistry() *rangeRegistry {
+	registryOnce.Do(func() {
+		registry = newRangeRegistry()
+	})
+	return registry
+}
+
+// looseRangeName applies the UAX #44 loose matching rule: case, whitespace,
+// hyphens and underscores are ignored.
+func looseRangeName(name string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(name))
+}
+
+func newRangeRegistry() *rangeRegistry {
+	r := &rangeRegistry{
+		entries: map[string]*rangeEntry{},
+		byLoose: map[string]*rangeEntry{},
+	}
+
+	r.addTables(rangeKindScript, scriptTables())
+	r.addTables(rangeKindCategory, categoryTables())
+	r.addTables(rangeKindProperty, propertyTables())
+	r.addTables(rangeKindProperty, emojiPropertyTables())
+
+	for _, alias := range propertyValueAliases() {
+		if alias.property != "sc" && alias.property != "gc" {
+			continue
+		}
+		var entry *rangeEntry
+		for _, name := range alias.names {
+			if entry = r.byLoose[looseRangeName(name)]; entry != nil {
+				break
+			}
+		}
+		if entry == nil {
+			continue
+		}
+		for _, name := range alias.names {
+			r.addAlias(name, entry)
+		}
+	}
+
+	for alias, name := range rangeAliases {
+		if entry, ok := r.entries[name]; ok {
+			r.addAlias(alias, entry)
+		}
+	}
+
+	// binary properties answer to their short names, i.e. STerm and WSpace,
+	// after the friendly names so "space" stays Z
+	for _, names := range propertyAliases() {
+		for _, name := range names {
+			entry := r.byLoose[looseRangeName(name)]
+			if entry == nil || entry.Kind != rangeKindProperty {
+				continue
+			}
+			for _, alias := range names {
+				r.addAlias(alias, entry)
+			}
+			break
+		}
+	}
+
+	return r
+}
+
+func (r *rangeRegistry) addTables(kind string, tables map[string]*unicode.RangeTable) {
+	for name, table := range tables {
+		entry := &rangeEntry{Name: strings.ToLower(name), Kind: kind, Table: table}
+		if _, ok := r.entries[entry.Name]; ok {
+			continue
+		}
+		r.entries[entry.Name] = entry
+		r.addAlias(name, entry)
+	}
+}
+
+// addAlias registers a loose name without overriding an existing one, so a
+// real table always wins over an alias of another table.
+func (r *rangeRegistry) addAlias(name string, entry *rangeEntry) {
+	loose := looseRangeName(name)
+	if _, ok := r.byLoose[loose]; !ok {
+		r.byLoose[loose] = entry
+	}
+}
+
+// lookup finds a range by its canonical name or any of its aliases.
+func (r *rangeRegistry) lookup(name string) (*rangeEntry, bool) {
+	entry, ok := r.byLoose[looseRangeName(name)]
+	return entry, ok
+}
+
+// list returns the ranges of one kind that should appear on the index,
+// sorted by name.
+func (r *rangeRegistry) list(kind string) []*rangeEntry {
+	var entries []*rangeEntry
+	for _, entry := range r.entries {
+		if entry.Kind == kind && !hiddenFromIndex[entry.Name] {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries
 }
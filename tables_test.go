@@ -0,0 +1,89 @@
+package main
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+	"unicode"
+)
+
+// lastCodepoint returns the highest codepoint in rangeTable.
+func lastCodepoint(rangeTable *unicode.RangeTable) rune {
+	if n := len(rangeTable.R32); n > 0 {
+		return rune(rangeTable.R32[n-1].Hi)
+	}
+	if n := len(rangeTable.R16); n > 0 {
+		return rune(rangeTable.R16[n-1].Hi)
+	}
+	return -1
+}
+
+// TestRangeRegistryGrids renders the grid of every table in the registry,
+// hidden or not, as every one of them can be requested on /range.
+func TestRangeRegistryGrids(t *testing.T) {
+	var names []string
+	for name := range getRangeRegistry().entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		entry := getRangeRegistry().entries[name]
+		withTimeout(t, 30*time.Second, func() {
+			tables, tableLengths := generateTableFromRTLiteral(entry.Table)
+			generateTableHTML(tables, tableLengths, entry.Table, nil)
+
+			last := lastCodepoint(entry.Table)
+			if last < 0 {
+				return
+			}
+			if len(tables) == 0 {
+				t.Errorf("%s: no rows for a table ending at %U", name, last)
+				return
+			}
+			lastTable := tables[len(tables)-1]
+			if lastRow := lastTable.rows[len(lastTable.rows)-1].row; last < lastRow[0] || last > lastRow[15] {
+				t.Errorf("%s: last row %U..%U does not hold %U", name, lastRow[0], lastRow[15], last)
+			}
+		})
+	}
+}
+
+func TestServeNoncharacters(t *testing.T) {
+	for _, target := range []string{"/range/noncharacter_code_point", "/api/v1/range/noncharacter_code_point"} {
+		withTimeout(t, 10*time.Second, func() {
+			if recorder := serveTestRequest(target); recorder.Code != 200 {
+				t.Errorf("%s: got status %d, want 200", target, recorder.Code)
+			}
+		})
+	}
+}
+
+// TestServeBaselineRanges requests every range name unicode.click has
+// answered to, testdata/range_routes.txt, so none of them go missing.
+func TestServeBaselineRanges(t *testing.T) {
+	routes, err := os.ReadFile("testdata/range_routes.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range strings.Fields(string(routes)) {
+		if recorder := serveTestRequest("/range/" + name); recorder.Code != 200 {
+			t.Errorf("/range/%s: got status %d, want 200", name, recorder.Code)
+		}
+	}
+
+	for alias, want := range map[string]string{
+		"sterm":  "sentence_terminal",
+		"STerm":  "sentence_terminal",
+		"WSpace": "white_space",
+		"NChar":  "noncharacter_code_point",
+		"space":  "z",
+		"EPres":  "emoji_presentation",
+	} {
+		if entry, ok := getRangeRegistry().lookup(alias); !ok || entry.Name != want {
+			t.Errorf("%s: got %+v, %t, want %s", alias, entry, ok, want)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"html/template"
+	"io/fs"
+	"log"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// templateDirectory holds the page templates in assets. Every page is
+// rendered through base.template.html, and handlers name their files relative
+// to the root of assets, i.e. "./template/rune.template.html".
+const templateDirectory = "./template"
+
+// templateReload makes the cache check the files of a template every time it
+// is used and parse them again when one of them changed, so templates can be
+// worked on without restarting the server. It is set by the dev setting, and
+// only useful with templates on disk, see assetDirectory.
+var templateReload bool
+
+// templateFuncs are available in every template. asset links a file of
+// public/, i.e. {{asset "res/shared.css"}}.
+var templateFuncs = template.FuncMap{
+	"asset": assetPath,
+}
+
+// templateAssetNames turns the file names handlers use into names in assets.
+func templateAssetNames(files []string) []string {
+	names := make([]string, len(files))
+	for i, file := range files {
+		names[i] = path.Clean(file)
+	}
+	return names
+}
+
+// cachedTemplate is a parsed set of template files with the modification
+// times they had when they were parsed.
+type cachedTemplate struct {
+	template *template.Template
+	files    []string
+	modTimes []time.Time
+}
+
+// templateCache keeps every set of template files that was rendered, keyed
+// by the file names, so they are only read and parsed once.
+type templateCache struct {
+	mutex     sync.RWMutex
+	templates map[string]*cachedTemplate
+}
+
+var templates = &templateCache{templates: map[string]*cachedTemplate{}}
+
+func templateModTimes(files []string) ([]time.Time, error) {
+	modTimes := make([]time.Time, len(files))
+	for i, name := range templateAssetNames(files) {
+		info, err := fs.Stat(assets, name)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[i] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+func parseCachedTemplate(files []string) (*cachedTemplate, error) {
+	modTimes, err := templateModTimes(files)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := template.New("").Funcs(templateFuncs).ParseFS(assets, templateAssetNames(files)...)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedTemplate{parsed, files, modTimes}, nil
+}
+
+// changed reports whether any of the files was modified since it was parsed.
+func (cached *cachedTemplate) changed() bool {
+	modTimes, err := templateModTimes(cached.files)
+	if err != nil {
+		// a file that went missing is reported when parsing again
+		return true
+	}
+	for i := range modTimes {
+		if !modTimes[i].Equal(cached.modTimes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// get returns the parsed template for files, parsing them on first use and,
+// with templateReload, again after they changed.
+func (cache *templateCache) get(files []string) (*template.Template, error) {
+	key := strings.Join(files, "\n")
+
+	cache.mutex.RLock()
+	cached, ok := cache.templates[key]
+	cache.mutex.RUnlock()
+	if ok && !(templateReload && cached.changed()) {
+		return cached.template, nil
+	}
+
+	cached, err := parseCachedTemplate(files)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		log.Printf("templates: reloaded %s", strings.Join(files, ", "))
+	}
+
+	cache.mutex.Lock()
+	cache.templates[key] = cached
+	cache.mutex.Unlock()
+	return cached.template, nil
+}
+
+// precompile parses every page template in templateDirectory together with
+// base.template.html, so that a broken template stops the server at startup
+// rather than failing requests.
+func (cache *templateCache) precompile() error {
+	pages, err := fs.Glob(assets, path.Join(templateDirectory, "*.template.html"))
+	if err != nil {
+		return err
+	}
+
+	base := templateDirectory + "/base.template.html"
+	for _, page := range pages {
+		page = templateDirectory + "/" + path.Base(page)
+		if page == base {
+			continue
+		}
+		if _, err := cache.get([]string{base, page}); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("templates: parsed %d pages", len(pages)-1)
+	return nil
+}
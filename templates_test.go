@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bytes"
+	"html/template"
+	"testing"
+)
+
+// BenchmarkRender renders a codepoint page from the template cache and, for
+// comparison, parsing its files on every request as before the cache.
+func BenchmarkRender(b *testing.B) {
+	files := []string{"./template/base.template.html", "./template/rune.template.html"}
+	data := getCodepointData('A')
+
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tmpl, err := templates.get(files)
+			if err != nil {
+				b.Fatal(err)
+			}
+			var buffer bytes.Buffer
+			if err := tmpl.ExecuteTemplate(&buffer, "base", data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("parsed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tmpl, err := template.New("").Funcs(templateFuncs).ParseFS(assets, templateAssetNames(files)...)
+			if err != nil {
+				b.Fatal(err)
+			}
+			var buffer bytes.Buffer
+			if err := tmpl.ExecuteTemplate(&buffer, "base", data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// certificateFiles serves the certificate in tls_cert and tls_key, which is
+// loaded again on SIGHUP so renewed files are picked up without a restart.
+type certificateFiles struct {
+	certFile, keyFile string
+
+	mutex       sync.RWMutex
+	certificate *tls.Certificate
+}
+
+func (files *certificateFiles) load() error {
+	certificate, err := tls.LoadX509KeyPair(files.certFile, files.keyFile)
+	if err != nil {
+		return err
+	}
+	files.mutex.Lock()
+	files.certificate = &certificate
+	files.mutex.Unlock()
+	return nil
+}
+
+func (files *certificateFiles) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	files.mutex.RLock()
+	defer files.mutex.RUnlock()
+	return files.certificate, nil
+}
+
+// reloadOnSIGHUP loads the files again on every SIGHUP. A certificate that
+// fails to load is logged and the one before it kept.
+func (files *certificateFiles) reloadOnSIGHUP() {
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+	for range hangups {
+		if err := files.load(); err != nil {
+			log.Printf("tls: reloading %s: %v, keeping the current certificate", files.certFile, err)
+			continue
+		}
+		log.Printf("tls: reloaded %s", files.certFile)
+	}
+}
+
+// acmeHosts are the hostnames certificates are requested for, acme_hosts or
+// the host of base_url.
+func acmeHosts() []string {
+	var hosts []string
+	for _, host := range strings.Split(config.ACMEHosts, ",") {
+		if host = strings.TrimSpace(host); host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	if len(hosts) == 0 {
+		if base, err := url.Parse(config.BaseURL); err == nil {
+			hosts = append(hosts, base.Hostname())
+		}
+	}
+	return hosts
+}
+
+// newACMEManager requests certificates from acme_directory, Let's Encrypt by
+// default, and keeps them in acme_cache. acme_ca_cert is trusted for the
+// directory, i.e. the root of a Pebble test server.
+func newACMEManager() (*autocert.Manager, error) {
+	manager := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      autocert.DirCache(config.ACMECache),
+		HostPolicy: autocert.HostWhitelist(acmeHosts()...),
+		Email:      config.ACMEEmail,
+	}
+
+	if config.ACMEDirectory == "" && config.ACMECACert == "" {
+		return manager, nil
+	}
+	client := &acme.Client{DirectoryURL: config.ACMEDirectory}
+	if config.ACMECACert != "" {
+		pem, err := os.ReadFile(config.ACMECACert)
+		if err != nil {
+			return nil, err
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s: no certificates found", config.ACMECACert)
+		}
+		client.HTTPClient = &http.Client{Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{RootCAs: roots},
+		}}
+	}
+	manager.Client = client
+	return manager, nil
+}
+
+// getTLSConfig returns the TLS config of the site and the handler of the
+// plain HTTP listener, which redirects to TLS and, with ACME, answers the
+// HTTP-01 challenges.
+func getTLSConfig() (*tls.Config, http.Handler, error) {
+	redirect := http.HandlerFunc(redirectToTLS)
+
+	if config.ACME {
+		manager, err := newACMEManager()
+		if err != nil {
+			return nil, nil, fmt.Errorf("acme: %w", err)
+		}
+		log.Printf("tls: ACME certificates for %s", strings.Join(acmeHosts(), ", "))
+		return manager.TLSConfig(), manager.HTTPHandler(redirect), nil
+	}
+
+	files := &certificateFiles{certFile: config.TLSCert, keyFile: config.TLSKey}
+	if err := files.load(); err != nil {
+		return nil, nil, err
+	}
+	go files.reloadOnSIGHUP()
+	return &tls.Config{GetCertificate: files.getCertificate}, redirect, nil
+}
@@ -0,0 +1,682 @@
+package main
+
+//go:generate go run gen_ucd.go -ucd ./ucd
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// ucdDirectory holds an unpacked copy of the Unicode Character Database
+// (https://www.unicode.org/Public/UCD/latest/ucd/UCD.zip). When it exists
+// its data replaces the built-in tables, which gen_ucd.go generates from a
+// single release, builtinUnicodeVersion, so the site can follow new Unicode
+// releases without a rebuild. It is set by the ucd setting, see serverConfig.
+var ucdDirectory = "./ucd"
+
+// ucd is the database loaded from ucdDirectory, nil when none was found.
+var ucd *ucdDatabase
+
+type ucdRange struct {
+	lo, hi rune
+	value  string
+}
+
+type ucdDatabase struct {
+	version string
+
+	names          map[rune]string
+	nameRanges     []ucdRange
+	nameAliases    []nameAlias
+	namedSequences []namedSequence
+	// namedSequencesVersion is the version of NamedSequences.txt, which
+	// may lag behind the other files
+	namedSequencesVersion string
+
+	caseMappings map[rune]caseMapping
+
+	categories       map[string]*unicode.RangeTable
+	scripts          map[string]*unicode.RangeTable
+	scriptExtensions []ucdRange
+	properties       map[string]*unicode.RangeTable
+
+	blocks  []ucdRange
+	ages    []ucdRange
+	aliases []propertyValueAlias
+	// propertyAliases are the lines of PropertyAliases.txt, short name
+	// first, then the long name and any other aliases
+	propertyAliases [][]string
+
+	bidiClasses  []ucdRange
+	bidiMirrored []ucdRange
+	mirrors      map[rune]rune
+	brackets     map[rune]bidiBracket
+
+	eastAsianWidths []ucdRange
+
+	confusables *confusableTables
+
+	emojiProperties map[string]*unicode.RangeTable
+	emojiSequences  map[string]emojiSequenceType
+	emojiGroups     []emojiGroup
+	emojiVersion    string
+
+	graphemeBreaks []ucdRange
+	wordBreaks     []ucdRange
+	// indicConjunctBreaks are the Indic_Conjunct_Break values of
+	// DerivedCoreProperties.txt
+	indicConjunctBreaks []ucdRange
+}
+
+// caseMapping is the simple case mapping of a codepoint, fields 12 to 14 of
+// UnicodeData.txt. Codepoints without one map to themselves.
+type caseMapping struct {
+	upper, lower, title rune
+}
+
+// propertyValueAlias is one line of PropertyValueAliases.txt, short name
+// first, then the long name and any other aliases.
+type propertyValueAlias struct {
+	property string
+	names    []string
+}
+
+var ucdVersionPattern = regexp.MustCompile(`^#\s*\w+-(\d+\.\d+\.\d+)\.txt`)
+
+// loadUCD reads the UCD files from directory. Files that are missing are
+// skipped so a partial download still improves on the built-in tables.
+func loadUCD(directory string) (*ucdDatabase, error) {
+	if _, err := os.Stat(directory); err != nil {
+		return nil, err
+	}
+
+	database := &ucdDatabase{names: map[rune]string{}, caseMappings: map[rune]caseMapping{}}
+
+	loaders := []struct {
+		file string
+		load func(string) error
+	}{
+		{"UnicodeData.txt", database.loadUnicodeData},
+		{"NameAliases.txt", database.loadNameAliases},
+		{"NamedSequences.txt", database.loadNamedSequences},
+		{"Scripts.txt", database.loadScripts},
+		{"ScriptExtensions.txt", database.loadScriptExtensions},
+		{"PropList.txt", database.loadPropList},
+		{"Blocks.txt", database.loadBlocks},
+		{"DerivedAge.txt", database.loadDerivedAge},
+		{"PropertyValueAliases.txt", database.loadPropertyValueAliases},
+		{"PropertyAliases.txt", database.loadPropertyAliases},
+		{"BidiMirroring.txt", database.loadBidiMirroring},
+		{"BidiBrackets.txt", database.loadBidiBrackets},
+		{"EastAsianWidth.txt", database.loadEastAsianWidth},
+		{confusablesFile, database.loadConfusables},
+		{emojiDataFile, database.loadEmojiData},
+		{emojiSequencesFile, database.loadEmojiSequences},
+		{emojiZWJSequencesFile, database.loadEmojiSequences},
+		{emojiTestFile, database.loadEmojiTest},
+		{"auxiliary/GraphemeBreakProperty.txt", database.loadGraphemeBreak},
+		{"auxiliary/WordBreakProperty.txt", database.loadWordBreak},
+		{"DerivedCoreProperties.txt", database.loadIndicConjunctBreak},
+	}
+
+	for _, loader := range loaders {
+		path := filepath.Join(directory, loader.file)
+		if _, err := os.Stat(path); err != nil {
+			log.Printf("ucd: %s not found, using built-in data", loader.file)
+			continue
+		}
+		if err := loader.load(path); err != nil {
+			return nil, fmt.Errorf("ucd: %s: %w", loader.file, err)
+		}
+	}
+
+	return database, nil
+}
+
+// parseUCDFile calls fn with the trimmed, semicolon separated fields of every
+// data line in a UCD file. Header comments are scanned for the file version.
+func parseUCDFile(path string, fn func(fields []string) error) (version string, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		if lineNumber == 1 {
+			// some of the files start with a byte order mark
+			line = strings.TrimPrefix(line, "\uFEFF")
+		}
+
+		if version == "" {
+			if match := ucdVersionPattern.FindStringSubmatch(line); match != nil {
+				version = match[1]
+			}
+		}
+
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if err := fn(fields); err != nil {
+			return version, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+	}
+
+	return version, scanner.Err()
+}
+
+// parseUCDCodepoints parses "0041" or "0041..005A".
+func parseUCDCodepoints(field string) (lo, hi rune, err error) {
+	loString, hiString, isRange := strings.Cut(field, "..")
+	loInt, err := strconv.ParseUint(loString, 16, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	hiInt := loInt
+	if isRange {
+		hiInt, err = strconv.ParseUint(hiString, 16, 32)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return rune(loInt), rune(hiInt), nil
+}
+
+func (database *ucdDatabase) setVersion(version string) {
+	if version != "" && database.version == "" {
+		database.version = version
+	}
+}
+
+func (database *ucdDatabase) loadUnicodeData(path string) error {
+	categoryRanges := map[string][]ucdRange{}
+	var rangeStart rune
+
+	_, err := parseUCDFile(path, func(fields []string) error {
+		if len(fields) < 15 {
+			return fmt.Errorf("expected 15 fields, got %d", len(fields))
+		}
+		codepoint, _, err := parseUCDCodepoints(fields[0])
+		if err != nil {
+			return err
+		}
+		name, category, bidiClass := fields[1], fields[2], fields[4]
+
+		lo := codepoint
+		switch {
+		case strings.HasSuffix(name, ", First>"):
+			rangeStart = codepoint
+			return nil
+		case strings.HasSuffix(name, ", Last>"):
+			lo = rangeStart
+			label := strings.TrimSuffix(strings.TrimPrefix(name, "<"), ", Last>")
+			database.nameRanges = append(database.nameRanges, ucdRange{lo, codepoint, label})
+		default:
+			database.names[codepoint] = name
+		}
+
+		categoryRanges[category] = append(categoryRanges[category], ucdRange{lo: lo, hi: codepoint})
+		major := category[:1]
+		categoryRanges[major] = append(categoryRanges[major], ucdRange{lo: lo, hi: codepoint})
+
+		database.bidiClasses = appendUCDRange(database.bidiClasses, ucdRange{lo, codepoint, bidiClass})
+		if fields[9] == "Y" {
+			database.bidiMirrored = appendUCDRange(database.bidiMirrored, ucdRange{lo: lo, hi: codepoint})
+		}
+
+		if fields[12] != "" || fields[13] != "" || fields[14] != "" {
+			mapping := caseMapping{codepoint, codepoint, 0}
+			for i, mapped := range []*rune{&mapping.upper, &mapping.lower, &mapping.title} {
+				if fields[12+i] == "" {
+					continue
+				}
+				if *mapped, _, err = parseUCDCodepoints(fields[12+i]); err != nil {
+					return err
+				}
+			}
+			// an empty titlecase mapping is the uppercase one
+			if mapping.title == 0 {
+				mapping.title = mapping.upper
+			}
+			database.caseMappings[codepoint] = mapping
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(database.nameRanges, func(i, j int) bool { return database.nameRanges[i].lo < database.nameRanges[j].lo })
+
+	database.categories = map[string]*unicode.RangeTable{}
+	for category, ranges := range categoryRanges {
+		database.categories[category] = newRangeTable(ranges)
+	}
+	return nil
+}
+
+// loadPropertyFile reads a "codepoints; Value" file into one table per value.
+func loadPropertyFile(path string) (tables map[string]*unicode.RangeTable, version string, err error) {
+	valueRanges := map[string][]ucdRange{}
+	version, err = parseUCDFile(path, func(fields []string) error {
+		if len(fields) < 2 {
+			return fmt.Errorf("expected 2 fields, got %d", len(fields))
+		}
+		lo, hi, err := parseUCDCodepoints(fields[0])
+		if err != nil {
+			return err
+		}
+		valueRanges[fields[1]] = append(valueRanges[fields[1]], ucdRange{lo: lo, hi: hi})
+		return nil
+	})
+	if err != nil {
+		return nil, version, err
+	}
+
+	tables = map[string]*unicode.RangeTable{}
+	for value, ranges := range valueRanges {
+		tables[value] = newRangeTable(ranges)
+	}
+	return tables, version, nil
+}
+
+func (database *ucdDatabase) loadScripts(path string) (err error) {
+	var version string
+	database.scripts, version, err = loadPropertyFile(path)
+	database.setVersion(version)
+	return err
+}
+
+func (database *ucdDatabase) loadScriptExtensions(path string) (err error) {
+	var version string
+	database.scriptExtensions, version, err = loadRangeList(path)
+	database.setVersion(version)
+	return err
+}
+
+func (database *ucdDatabase) loadPropList(path string) (err error) {
+	var version string
+	database.properties, version, err = loadPropertyFile(path)
+	database.setVersion(version)
+	return err
+}
+
+// loadRangeList reads a "codepoints; Value" file into a sorted list of ranges.
+func loadRangeList(path string) (ranges []ucdRange, version string, err error) {
+	version, err = parseUCDFile(path, func(fields []string) error {
+		if len(fields) < 2 {
+			return fmt.Errorf("expected 2 fields, got %d", len(fields))
+		}
+		lo, hi, err := parseUCDCodepoints(fields[0])
+		if err != nil {
+			return err
+		}
+		ranges = append(ranges, ucdRange{lo, hi, fields[1]})
+		return nil
+	})
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+	return ranges, version, err
+}
+
+func (database *ucdDatabase) loadBlocks(path string) (err error) {
+	var version string
+	database.blocks, version, err = loadRangeList(path)
+	database.setVersion(version)
+	return err
+}
+
+func (database *ucdDatabase) loadDerivedAge(path string) (err error) {
+	var version string
+	database.ages, version, err = loadRangeList(path)
+	database.setVersion(version)
+	return err
+}
+
+func (database *ucdDatabase) loadEastAsianWidth(path string) (err error) {
+	var version string
+	database.eastAsianWidths, version, err = loadRangeList(path)
+	database.setVersion(version)
+	return err
+}
+
+func (database *ucdDatabase) loadGraphemeBreak(path string) (err error) {
+	database.graphemeBreaks, _, err = loadRangeList(path)
+	return err
+}
+
+func (database *ucdDatabase) loadWordBreak(path string) (err error) {
+	database.wordBreaks, _, err = loadRangeList(path)
+	return err
+}
+
+// loadIndicConjunctBreak reads the InCB lines of DerivedCoreProperties.txt,
+// the only ones with a value.
+func (database *ucdDatabase) loadIndicConjunctBreak(path string) error {
+	database.indicConjunctBreaks = []ucdRange{}
+	_, err := parseUCDFile(path, func(fields []string) error {
+		if len(fields) < 3 || fields[1] != "InCB" {
+			return nil
+		}
+		lo, hi, err := parseUCDCodepoints(fields[0])
+		if err != nil {
+			return err
+		}
+		database.indicConjunctBreaks = append(database.indicConjunctBreaks, ucdRange{lo, hi, fields[2]})
+		return nil
+	})
+	sort.Slice(database.indicConjunctBreaks, func(i, j int) bool {
+		return database.indicConjunctBreaks[i].lo < database.indicConjunctBreaks[j].lo
+	})
+	return err
+}
+
+func (database *ucdDatabase) loadPropertyValueAliases(path string) error {
+	version, err := parseUCDFile(path, func(fields []string) error {
+		if len(fields) < 3 {
+			return fmt.Errorf("expected at least 3 fields, got %d", len(fields))
+		}
+		database.aliases = append(database.aliases, propertyValueAlias{fields[0], fields[1:]})
+		return nil
+	})
+	database.setVersion(version)
+	return err
+}
+
+func (database *ucdDatabase) loadPropertyAliases(path string) error {
+	version, err := parseUCDFile(path, func(fields []string) error {
+		if len(fields) < 2 {
+			return fmt.Errorf("expected at least 2 fields, got %d", len(fields))
+		}
+		database.propertyAliases = append(database.propertyAliases, fields)
+		return nil
+	})
+	database.setVersion(version)
+	return err
+}
+
+func (database *ucdDatabase) loadBidiMirroring(path string) error {
+	database.mirrors = map[rune]rune{}
+	version, err := parseUCDFile(path, func(fields []string) error {
+		if len(fields) < 2 {
+			return fmt.Errorf("expected 2 fields, got %d", len(fields))
+		}
+		codepoint, _, err := parseUCDCodepoints(fields[0])
+		if err != nil {
+			return err
+		}
+		mirror, _, err := parseUCDCodepoints(fields[1])
+		if err != nil {
+			return err
+		}
+		database.mirrors[codepoint] = mirror
+		return nil
+	})
+	database.setVersion(version)
+	return err
+}
+
+func (database *ucdDatabase) loadBidiBrackets(path string) error {
+	database.brackets = map[rune]bidiBracket{}
+	version, err := parseUCDFile(path, func(fields []string) error {
+		if len(fields) < 3 {
+			return fmt.Errorf("expected 3 fields, got %d", len(fields))
+		}
+		codepoint, _, err := parseUCDCodepoints(fields[0])
+		if err != nil {
+			return err
+		}
+		pair, _, err := parseUCDCodepoints(fields[1])
+		if err != nil {
+			return err
+		}
+		database.brackets[codepoint] = bidiBracket{pair, fields[2] == "o"}
+		return nil
+	})
+	database.setVersion(version)
+	return err
+}
+
+// appendUCDRange appends r to a sorted list, extending the last range
+// instead when r follows it directly and has the same value.
+func appendUCDRange(ranges []ucdRange, r ucdRange) []ucdRange {
+	if last := len(ranges) - 1; last >= 0 && ranges[last].hi+1 == r.lo && ranges[last].value == r.value {
+		ranges[last].hi = r.hi
+		return ranges
+	}
+	return append(ranges, r)
+}
+
+// findUCDRange returns the range containing codepoint in a sorted,
+// non-overlapping list.
+func findUCDRange(ranges []ucdRange, codepoint rune) (ucdRange, bool) {
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].hi >= codepoint })
+	if i < len(ranges) && ranges[i].lo <= codepoint {
+		return ranges[i], true
+	}
+	return ucdRange{}, false
+}
+
+// newRangeTable builds a unicode.RangeTable out of arbitrary codepoint
+// ranges, merging the ones that touch.
+func newRangeTable(ranges []ucdRange) *unicode.RangeTable {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+
+	var merged []ucdRange
+	for _, r := range ranges {
+		if len(merged) > 0 && r.lo <= merged[len(merged)-1].hi+1 {
+			if r.hi > merged[len(merged)-1].hi {
+				merged[len(merged)-1].hi = r.hi
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	rangeTable := &unicode.RangeTable{}
+	for _, r := range merged {
+		if r.lo <= 0xFFFF {
+			hi := r.hi
+			if hi > 0xFFFF {
+				hi = 0xFFFF
+			}
+			rangeTable.R16 = append(rangeTable.R16, unicode.Range16{Lo: uint16(r.lo), Hi: uint16(hi), Stride: 1})
+			if hi <= unicode.MaxLatin1 {
+				rangeTable.LatinOffset++
+			}
+			if r.hi <= 0xFFFF {
+				continue
+			}
+			r.lo = 0x10000
+		}
+		rangeTable.R32 = append(rangeTable.R32, unicode.Range32{Lo: uint32(r.lo), Hi: uint32(r.hi), Stride: 1})
+	}
+	return rangeTable
+}
+
+// unicodeVersion is the version of the Unicode data the site is serving.
+func unicodeVersion() string {
+	if ucd != nil && ucd.version != "" {
+		return ucd.version
+	}
+	return builtinUnicodeVersion
+}
+
+// runeName returns the character name of a codepoint, deriving the names of
+// ideographs and Hangul syllables that UnicodeData.txt only lists as ranges.
+func runeName(codepoint rune) string {
+	nameRanges := builtinNameRanges
+	if ucd != nil && len(ucd.names) > 0 {
+		if name, ok := ucd.names[codepoint]; ok {
+			return name
+		}
+		nameRanges = ucd.nameRanges
+	} else if name, ok := findUCDRange(builtinNames, codepoint); ok {
+		return name.value
+	}
+	if nameRange, ok := findUCDRange(nameRanges, codepoint); ok {
+		label := strings.TrimSuffix(nameRange.value, " Supplement")
+		switch {
+		case label == "Hangul Syllable":
+			return hangulSyllableName(codepoint)
+		case strings.HasPrefix(label, "CJK Ideograph"):
+			return fmt.Sprintf("CJK UNIFIED IDEOGRAPH-%04X", codepoint)
+		case strings.HasSuffix(label, "Ideograph"):
+			return fmt.Sprintf("%s-%04X", strings.ToUpper(label), codepoint)
+		}
+	}
+	return ""
+}
+
+var (
+	hangulLeads  = []string{"G", "GG", "N", "D", "DD", "R", "M", "B", "BB", "S", "SS", "", "J", "JJ", "C", "K", "T", "P", "H"}
+	hangulVowels = []string{"A", "AE", "YA", "YAE", "EO", "E", "YEO", "YE", "O", "WA", "WAE", "OE", "YO", "U", "WEO", "WE", "WI", "YU", "EU", "YI", "I"}
+	hangulTrails = []string{"", "G", "GG", "GS", "N", "NJ", "NH", "D", "L", "LG", "LM", "LB", "LS", "LT", "LP", "LH", "M", "B", "BS", "S", "SS", "NG", "J", "C", "K", "T", "P", "H"}
+)
+
+// hangulSyllableName implements the algorithm from section 3.12 of the
+// Unicode Standard.
+func hangulSyllableName(codepoint rune) string {
+	index := int(codepoint - 0xAC00)
+	lead := index / (len(hangulVowels) * len(hangulTrails))
+	vowel := (index % (len(hangulVowels) * len(hangulTrails))) / len(hangulTrails)
+	trail := index % len(hangulTrails)
+	return "HANGUL SYLLABLE " + hangulLeads[lead] + hangulVowels[vowel] + hangulTrails[trail]
+}
+
+func propertyValueAliases() []propertyValueAlias {
+	if ucd != nil && ucd.aliases != nil {
+		return ucd.aliases
+	}
+	return builtinAliases
+}
+
+func propertyAliases() [][]string {
+	if ucd != nil && ucd.propertyAliases != nil {
+		return ucd.propertyAliases
+	}
+	return builtinPropertyAliases
+}
+
+// builtinTables are the category, script and property tables built out of
+// the generated range lists, once.
+var (
+	builtinTables struct {
+		categories, scripts, properties map[string]*unicode.RangeTable
+	}
+	builtinTablesOnce sync.Once
+)
+
+// rangeTablesByValue builds one table per value of ranges.
+func rangeTablesByValue(ranges []ucdRange) map[string]*unicode.RangeTable {
+	valueRanges := map[string][]ucdRange{}
+	for _, r := range ranges {
+		valueRanges[r.value] = append(valueRanges[r.value], r)
+	}
+	tables := map[string]*unicode.RangeTable{}
+	for value, ranges := range valueRanges {
+		tables[value] = newRangeTable(ranges)
+	}
+	return tables
+}
+
+func getBuiltinTables() {
+	builtinTablesOnce.Do(func() {
+		// major categories are the union of their two letter ones, as
+		// loadUnicodeData builds them
+		categories := append([]ucdRange{}, builtinCategories...)
+		for _, r := range builtinCategories {
+			categories = append(categories, ucdRange{r.lo, r.hi, r.value[:1]})
+		}
+		builtinTables.categories = rangeTablesByValue(categories)
+		builtinTables.scripts = rangeTablesByValue(builtinScripts)
+		builtinTables.properties = rangeTablesByValue(builtinProperties)
+	})
+}
+
+func categoryTables() map[string]*unicode.RangeTable {
+	if ucd != nil && ucd.categories != nil {
+		return ucd.categories
+	}
+	getBuiltinTables()
+	return builtinTables.categories
+}
+
+// inCategories reports whether codepoint belongs to one of the one or two
+// letter General_Category values, like unicode.In does with Go's tables.
+func inCategories(codepoint rune, categories ...string) bool {
+	tables := categoryTables()
+	for _, category := range categories {
+		if table, ok := tables[category]; ok && unicode.Is(table, codepoint) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPrint is unicode.IsPrint with the loaded tables: letters, marks,
+// numbers, punctuation, symbols and the ASCII space.
+func isPrint(codepoint rune) bool {
+	return codepoint == ' ' || inCategories(codepoint, "L", "M", "N", "P", "S")
+}
+
+// hasProperty reports whether codepoint has a PropList.txt property.
+func hasProperty(codepoint rune, property string) bool {
+	table, ok := propertyTables()[property]
+	return ok && unicode.Is(table, codepoint)
+}
+
+// getCaseMapping returns the simple case mappings of codepoint, the
+// replacements of unicode.ToUpper, ToLower and ToTitle.
+func getCaseMapping(codepoint rune) caseMapping {
+	mappings := builtinCaseMappings
+	if ucd != nil && len(ucd.caseMappings) > 0 {
+		mappings = ucd.caseMappings
+	}
+	if mapping, ok := mappings[codepoint]; ok {
+		return mapping
+	}
+	return caseMapping{codepoint, codepoint, codepoint}
+}
+
+func scriptTables() map[string]*unicode.RangeTable {
+	if ucd != nil && ucd.scripts != nil {
+		return ucd.scripts
+	}
+	getBuiltinTables()
+	return builtinTables.scripts
+}
+
+func scriptExtensionList() []ucdRange {
+	if ucd != nil && ucd.scriptExtensions != nil {
+		return ucd.scriptExtensions
+	}
+	return builtinScriptExtensions
+}
+
+func propertyTables() map[string]*unicode.RangeTable {
+	if ucd != nil && ucd.properties != nil {
+		return ucd.properties
+	}
+	getBuiltinTables()
+	return builtinTables.properties
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+// TestBuiltinTablesAgree checks that the built-in tables come from one
+// release: everything assigned has a name, an age and a block.
+func TestBuiltinTablesAgree(t *testing.T) {
+	failures := 0
+	for codepoint := rune(0); codepoint <= unicode.MaxRune && failures < 10; codepoint++ {
+		category := getGeneralCategory(codepoint)
+		if category == "Cn" {
+			continue
+		}
+		if getAge(codepoint) == "" {
+			t.Errorf("%U is %s but has no age", codepoint, category)
+			failures++
+		}
+		if _, ok := getBlock(codepoint); !ok {
+			t.Errorf("%U is %s but in no block", codepoint, category)
+			failures++
+		}
+		if category != "Co" && category != "Cs" && runeName(codepoint) == "" {
+			t.Errorf("%U is %s but has no name", codepoint, category)
+			failures++
+		}
+	}
+}
+
+func TestBuiltinVersion(t *testing.T) {
+	if version := unicodeVersion(); version != builtinUnicodeVersion {
+		t.Errorf("got version %s, want %s", version, builtinUnicodeVersion)
+	}
+	latest := builtinUnicodeVersion[:strings.LastIndexByte(builtinUnicodeVersion, '.')]
+	if recorder := serveTestRequest("/age/" + latest); recorder.Code != 200 {
+		t.Errorf("/age/%s: got status %d, want 200", latest, recorder.Code)
+	}
+
+	var data CodepointData
+	recorder := serveTestRequest("/api/v1/cp/U+1F979")
+	if err := json.NewDecoder(recorder.Body).Decode(&data); err != nil {
+		t.Fatalf("got status %d, %v", recorder.Code, err)
+	}
+	if data.RuneName != "FACE HOLDING BACK TEARS" || data.Age != "14.0" || data.UnicodeVersion != builtinUnicodeVersion {
+		t.Errorf("got %q, age %q in Unicode %s", data.RuneName, data.Age, data.UnicodeVersion)
+	}
+}
+
+// TestBuiltinCoversToolchain checks that the built-in tables are no older
+// than Go's, so the predicates and case mappings getCodepointData reads from
+// them agree with the unicode package on everything it knows about.
+func TestBuiltinCoversToolchain(t *testing.T) {
+	if recorder := serveTestRequest("/cp/U+31350"); recorder.Code != 200 {
+		t.Errorf("/cp/U+31350: got status %d, want 200", recorder.Code)
+	}
+
+	failures := 0
+	for codepoint := rune(0); codepoint <= unicode.MaxRune && failures < 10; codepoint++ {
+		if !unicode.In(codepoint, unicode.L, unicode.M, unicode.N, unicode.P, unicode.S, unicode.Z, unicode.C) ||
+			unicode.Is(unicode.Cs, codepoint) {
+			continue
+		}
+		mapping := getCaseMapping(codepoint)
+		got := []interface{}{
+			inCategories(codepoint, "Cc"), inCategories(codepoint, "Nd"),
+			inCategories(codepoint, "L", "M", "N", "P", "S", "Zs"), inCategories(codepoint, "L"),
+			inCategories(codepoint, "Ll"), inCategories(codepoint, "M"), inCategories(codepoint, "N"),
+			isPrint(codepoint), inCategories(codepoint, "P"), hasProperty(codepoint, "White_Space"),
+			inCategories(codepoint, "S"), inCategories(codepoint, "Lt"), inCategories(codepoint, "Lu"),
+			mapping.upper, mapping.lower, mapping.title,
+		}
+		want := []interface{}{
+			unicode.IsControl(codepoint), unicode.IsDigit(codepoint),
+			unicode.IsGraphic(codepoint), unicode.IsLetter(codepoint),
+			unicode.IsLower(codepoint), unicode.IsMark(codepoint), unicode.IsNumber(codepoint),
+			unicode.IsPrint(codepoint), unicode.IsPunct(codepoint), unicode.IsSpace(codepoint),
+			unicode.IsSymbol(codepoint), unicode.IsTitle(codepoint), unicode.IsUpper(codepoint),
+			unicode.ToUpper(codepoint), unicode.ToLower(codepoint), unicode.ToTitle(codepoint),
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%U: got %+v, want %+v", codepoint, got, want)
+			failures++
+		}
+	}
+}
+
+func TestRuneNameRanges(t *testing.T) {
+	for codepoint, want := range map[rune]string{
+		0x4E00:  "CJK UNIFIED IDEOGRAPH-4E00",
+		0xAC00:  "HANGUL SYLLABLE GA",
+		0x17000: "TANGUT IDEOGRAPH-17000",
+		0x18D00: "TANGUT IDEOGRAPH-18D00",
+		0xE000:  "",
+	} {
+		if got := runeName(codepoint); got != want {
+			t.Errorf("runeName(%U) = %q, want %q", codepoint, got, want)
+		}
+	}
+}
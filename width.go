@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// eastAsianWidthNames maps the East_Asian_Width values of UAX #11 to their
+// long names.
+var eastAsianWidthNames = map[string]string{
+	"A":  "Ambiguous",
+	"F":  "Fullwidth",
+	"H":  "Halfwidth",
+	"N":  "Neutral",
+	"Na": "Narrow",
+	"W":  "Wide",
+}
+
+// Width is how many columns a codepoint takes up in a terminal.
+type Width struct {
+	EastAsianWidth     string `json:"east_asian_width"`
+	EastAsianWidthName string `json:"east_asian_width_name"`
+	// Columns follows wcwidth(3): -1 for control characters, which have no
+	// width of their own, 0 for marks and format characters, 2 for wide and
+	// fullwidth characters and 1 for the rest. ColumnsCJK is the same with
+	// ambiguous characters counted as wide, as terminals in CJK locales do.
+	Columns    int `json:"columns"`
+	ColumnsCJK int `json:"columns_cjk"`
+}
+
+func getEastAsianWidth(codepoint rune) string {
+	eastAsianWidths := builtinEastAsianWidths
+	if ucd != nil && ucd.eastAsianWidths != nil {
+		eastAsianWidths = ucd.eastAsianWidths
+	}
+	if eastAsianWidth, ok := findUCDRange(eastAsianWidths, codepoint); ok {
+		return eastAsianWidth.value
+	}
+	// unlisted codepoints default to Neutral
+	return "N"
+}
+
+// columnWidth is the wcwidth of codepoint, with ambiguous characters taking
+// up ambiguousColumns.
+func columnWidth(codepoint rune, eastAsianWidth string, ambiguousColumns int) int {
+	switch {
+	case codepoint == 0:
+		return 0
+	case codepoint < 0x20 || (codepoint >= 0x7F && codepoint < 0xA0):
+		return -1
+	case codepoint == 0xAD:
+		// SOFT HYPHEN is shown when a line breaks at it
+		return 1
+	case inCategories(codepoint, "Mn", "Me", "Cf"):
+		return 0
+	case codepoint == 0x200B:
+		return 0
+	case codepoint >= 0x1160 && codepoint <= 0x11FF, codepoint >= 0xD7B0 && codepoint <= 0xD7FF:
+		// Hangul medial vowels and final consonants join the syllable before
+		return 0
+	}
+
+	switch eastAsianWidth {
+	case "W", "F":
+		return 2
+	case "A":
+		return ambiguousColumns
+	}
+	return 1
+}
+
+func getWidth(codepoint rune) Width {
+	eastAsianWidth := getEastAsianWidth(codepoint)
+	return Width{
+		EastAsianWidth:     eastAsianWidth,
+		EastAsianWidthName: eastAsianWidthNames[eastAsianWidth],
+		Columns:            columnWidth(codepoint, eastAsianWidth, 1),
+		ColumnsCJK:         columnWidth(codepoint, eastAsianWidth, 2),
+	}
+}
+
+// widthFilters are the values accepted by the "width" parameter of range
+// pages.
+var widthFilters = map[string]string{
+	"wide":      "W",
+	"fullwidth": "F",
+	"ambiguous": "A",
+	"halfwidth": "H",
+	"narrow":    "Na",
+	"neutral":   "N",
+}
+
+// getWidthFilter reads the comma separated "width" parameter of range pages
+// into the set of East_Asian_Width values to highlight, nil when there is
+// none.
+func getWidthFilter(request *http.Request) (map[string]bool, error) {
+	parameter := request.URL.Query().Get("width")
+	if parameter == "" {
+		return nil, nil
+	}
+
+	filter := map[string]bool{}
+	for _, value := range strings.Split(parameter, ",") {
+		eastAsianWidth, ok := widthFilters[strings.ToLower(strings.TrimSpace(value))]
+		if !ok {
+			return nil, &inputError{errMalformedInput, value, []suggestion{
+				{"highlight wide and fullwidth characters", fmt.Sprintf("%s?width=wide,fullwidth", request.URL.Path)},
+				{"highlight ambiguous characters", fmt.Sprintf("%s?width=ambiguous", request.URL.Path)},
+			}}
+		}
+		filter[eastAsianWidth] = true
+	}
+	return filter, nil
+}
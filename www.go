@@ -1,8 +1,10 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"html/template"
 	"log"
 	"math/rand"
 	"net/http"
@@ -32,26 +34,99 @@ func setHeaders(writer http.ResponseWriter) http.ResponseWriter {
 	return writer
 }
 
-func serveFilesFromTemplate(writer http.ResponseWriter, request *http.Request, templates []string, data interface{}, timer time.Time) {
-	tmpl, err := template.New("").ParseFiles(templates...)
+func setJSONHeaders(writer http.ResponseWriter) http.ResponseWriter {
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writer.Header().Set("Cache-Control", "public, max-age=3600")
+	writer.Header().Set("X-Powered-By", "Diet Coke")
+	writer.Header().Set("ETag", ucVersion)
+	writer.Header().Set("Access-Control-Allow-Origin", "*")
+
+	return writer
+}
+
+// wantsJSON reports whether the client asked for JSON rather than HTML
+// through its Accept header.
+func wantsJSON(request *http.Request) bool {
+	for _, accept := range strings.Split(request.Header.Get("Accept"), ",") {
+		mediaType, _, _ := strings.Cut(strings.TrimSpace(accept), ";")
+		switch mediaType {
+		case "application/json":
+			return true
+		case "text/html":
+			return false
+		}
+	}
+	return false
+}
+
+func serveJSON(writer http.ResponseWriter, request *http.Request, data interface{}, timer time.Time) {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(data)
 	if err != nil {
 		log.Print(err.Error())
 		http.Error(writer, "Internal Server Error", http.StatusInternalServerError)
 		return
 	}
-	err = tmpl.ExecuteTemplate(writer, "base", data)
+
+	logNow(request, timer)
+}
+
+func serveJSONError(writer http.ResponseWriter, request *http.Request, err error, timer time.Time) {
+	data := struct {
+		Error       string       `json:"error"`
+		Input       string       `json:"input,omitempty"`
+		Suggestions []suggestion `json:"suggestions,omitempty"`
+	}{Error: err.Error()}
+
+	var inputErr *inputError
+	if errors.As(err, &inputErr) {
+		data.Error = inputErr.err.Error()
+		data.Input = inputErr.Input
+		data.Suggestions = inputErr.Suggestions
+	}
+
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writer.Header().Set("Cache-Control", "no-cache")
+	writer.Header().Del("ETag")
+	writer.WriteHeader(errorStatus(err))
+	json.NewEncoder(writer).Encode(data)
+
+	logNow(request, timer)
+}
+
+func serveFilesFromTemplate(writer http.ResponseWriter, request *http.Request, templateFiles []string, data interface{}, timer time.Time) {
+	serveFilesFromTemplateWithStatus(writer, request, templateFiles, data, http.StatusOK, timer)
+}
+
+// serveFilesFromTemplateWithStatus renders into a buffer first so a failing
+// template can still be answered with a clean 500. Templates come from the
+// cache in templates.go.
+func serveFilesFromTemplateWithStatus(writer http.ResponseWriter, request *http.Request, templateFiles []string, data interface{}, status int, timer time.Time) {
+	tmpl, err := templates.get(templateFiles)
 	if err != nil {
 		log.Print(err.Error())
 		http.Error(writer, "Internal Server Error", http.StatusInternalServerError)
 		return
 	}
+	var buffer bytes.Buffer
+	err = tmpl.ExecuteTemplate(&buffer, "base", data)
+	if err != nil {
+		log.Print(err.Error())
+		http.Error(writer, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	writer.WriteHeader(status)
+	buffer.WriteTo(writer)
 
 	logNow(request, timer)
 }
 
 func redirectToTLS(writer http.ResponseWriter, request *http.Request) {
 	log.Printf("Redirecting, %v, %v\n", request.RemoteAddr, request.URL)
-	http.Redirect(writer, request, "https://unicode.click:443"+request.RequestURI, http.StatusMovedPermanently)
+	http.Redirect(writer, request, absoluteURL(request.RequestURI), http.StatusMovedPermanently)
 }
 
 func serveIndex(writer http.ResponseWriter, request *http.Request, timer time.Time) {
@@ -59,7 +134,20 @@ func serveIndex(writer http.ResponseWriter, request *http.Request, timer time.Ti
 		"./template/base.template.html",
 		"./template/index.template.html",
 	}
-	serveFilesFromTemplate(writer, request, templateFiles, struct{ UnicodeVersion string }{UnicodeVersion: unicode.Version}, timer)
+	data := struct {
+		UnicodeVersion string
+		Properties     []*rangeEntry
+		Categories     []*rangeEntry
+		Scripts        []*rangeEntry
+		Blocks         []blockLink
+	}{
+		UnicodeVersion: unicodeVersion(),
+		Properties:     getRangeRegistry().list(rangeKindProperty),
+		Categories:     getRangeRegistry().list(rangeKindCategory),
+		Scripts:        getRangeRegistry().list(rangeKindScript),
+		Blocks:         getBlockLinks(),
+	}
+	serveFilesFromTemplate(writer, request, templateFiles, data, timer)
 }
 
 func getRandomRune(maximum int) rune {
@@ -76,14 +164,14 @@ func getRandomRune(maximum int) rune {
 
 	// filter out hanja
 	// otherwise its literally always hanja
-	if unicode.In(randy, unicode.Han) {
+	if unicode.Is(scriptTables()["Han"], randy) {
 		if randy%6 == 0 {
 			return randy
 		}
 		return getRandomRune(maximum / 2)
 	}
 
-	if unicode.IsPrint(randy) {
+	if isPrint(randy) {
 		return randy
 	}
 	return getRandomRune(maximum / 2)
@@ -91,7 +179,7 @@ func getRandomRune(maximum int) rune {
 
 func serveRandom(writer http.ResponseWriter, request *http.Request, timer time.Time) {
 	random := getRandomRune(0)
-	http.Redirect(writer, request, "https://unicode.click:443/cp/"+string(random), http.StatusSeeOther)
+	http.Redirect(writer, request, absoluteURL(fmt.Sprintf("/cp/%U", random)), http.StatusSeeOther)
 	logNow(request, timer)
 }
 
@@ -105,21 +193,106 @@ func serveUnicodeClick(writer http.ResponseWriter, request *http.Request, params
 	case route == "/":
 		serveIndex(writer, request, timer)
 		return
+	case route == "/search":
+		serveSearch(writer, request, timer)
+		return
+	case route == "/api/v1/search":
+		serveSearchJSON(writer, request, timer)
+		return
+	case route == "/inspect":
+		serveInspect(writer, request, timer)
+		return
+	case route == "/api/v1/inspect":
+		serveInspectJSON(writer, request, timer)
+		return
+	case route == "/normalize":
+		serveNormalize(writer, request, timer)
+		return
+	case route == "/api/v1/normalize":
+		serveNormalizeJSON(writer, request, timer)
+		return
+	case route == "/bidi":
+		serveBidi(writer, request, timer)
+		return
+	case route == "/api/v1/bidi":
+		serveBidiJSON(writer, request, timer)
+		return
+	case route == "/confusable":
+		serveConfusable(writer, request, timer)
+		return
+	case route == "/api/v1/confusable":
+		serveConfusableJSON(writer, request, timer)
+		return
+	case route == "/segment":
+		serveSegment(writer, request, timer)
+		return
+	case route == "/api/v1/segment":
+		serveSegmentJSON(writer, request, timer)
+		return
+	case route == "/emoji":
+		serveEmojiBrowser(writer, request, timer)
+		return
+	case route == "/api/v1/emoji":
+		serveEmojiBrowserJSON(writer, request, timer)
+		return
+	case route == "/changelog":
+		serveChangelog(writer, request, timer)
+		return
+	case route == "/api/v1/changelog":
+		serveChangelogJSON(writer, request, timer)
+		return
 	case route == "/random":
 		serveRandom(writer, request, timer)
 		return
+	case len(route) >= 12 && route[0:11] == "/api/v1/cp/":
+		route = route[11:]
+		serveCodepointJSON(writer, request, route, timer)
+		return
+	case len(route) >= 15 && route[0:14] == "/api/v1/range/":
+		route = route[14:]
+		serveRangeExport(writer, request, route, timer, lookupRange)
+		return
+	case len(route) >= 15 && route[0:14] == "/api/v1/block/":
+		route = route[14:]
+		serveRangeExport(writer, request, route, timer, lookupBlockRange)
+		return
+	case len(route) >= 13 && route[0:12] == "/api/v1/age/":
+		route = route[12:]
+		serveRangeExport(writer, request, route, timer, lookupAgeRange)
+		return
+	case len(route) >= 14 && route[0:13] == "/api/v1/name/":
+		route = route[13:]
+		serveNameJSON(writer, request, route, timer)
+		return
+	case len(route) >= 15 && route[0:14] == "/api/v1/emoji/":
+		route = route[14:]
+		serveEmojiSequenceJSON(writer, request, route, timer)
+		return
+	case len(route) >= 8 && route[0:7] == "/emoji/":
+		route = route[7:]
+		serveEmojiSequence(writer, request, route, timer)
+		return
 	case len(route) >= 8 && route[0:6] == "/range":
 		route = route[7:]
 		serveRange(writer, request, route, timer)
 		return
+	case len(route) >= 7 && route[0:6] == "/name/":
+		route = route[6:]
+		serveName(writer, request, route, timer)
+		return
+	case len(route) >= 6 && route[0:5] == "/age/":
+		route = route[5:]
+		serveAge(writer, request, route, timer)
+		return
+	case len(route) >= 8 && route[0:7] == "/block/":
+		route = route[7:]
+		serveBlock(writer, request, route, timer)
+		return
 	case len(route) >= 5 && route[0:3] == "/cp":
 		route = route[4:]
 		serveCodepoint(writer, request, route, timer)
 		return
 	}
 
-	route = "public" + route
-
-	http.ServeFile(writer, request, route)
-	logNow(request, timer)
+	serveStatic(writer, request, route, timer)
 }